@@ -3,6 +3,7 @@ package acks
 import (
 	"time"
 
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 	"github.com/google/uuid"
 )
@@ -14,16 +15,20 @@ type Token struct {
 	mustack   []*player.Player // who must ack
 	mustackin time.Duration    // how long they have to ack
 	start     time.Time
+	clock     clock.Clock
 }
 
-// New creates a new ack token
-func New(mustack []*player.Player, mustackin time.Duration) *Token {
+// New creates a new ack token, timed by the clock
+func New(c clock.Clock, mustack []*player.Player, mustackin time.Duration) *Token {
+	c = clock.OrReal(c)
+
 	return &Token{
 		id:        uuid.New().String(),
 		acked:     make(map[*player.Player]bool),
 		mustack:   mustack,
 		mustackin: mustackin,
-		start:     time.Now(),
+		start:     c.Now(),
+		clock:     c,
 	}
 }
 
@@ -34,7 +39,7 @@ func (t *Token) String() string {
 
 // StartTimer starts the ack timer
 func (t *Token) StartTimer() {
-	t.start = time.Now()
+	t.start = t.clock.Now()
 }
 
 // Ack records a player acking a token
@@ -45,7 +50,7 @@ func (t *Token) Ack(p *player.Player) error {
 
 // TimeRemaining returns time left until token expires
 func (t *Token) TimeRemaining() time.Duration {
-	return -(time.Now().Sub(t.start) - t.mustackin)
+	return -(t.clock.Since(t.start) - t.mustackin)
 }
 
 // NumStillToAck returns the number of players that still need to ack the token
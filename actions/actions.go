@@ -2,6 +2,7 @@ package actions
 
 import (
 	"context"
+	"time"
 
 	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
 )
@@ -15,6 +16,20 @@ type PlayerAction struct {
 	// use this channel to send back game data to the client
 	ToClientChan chan GameData
 
+	// selects the tables listed in the lobby
+	TableFilter *ppb.TableFilter
+	// the table to create
+	TableConfig *ppb.TableConfig
+	// usernames to invite to and revoke from a private table
+	Invite, Revoke []string
+
+	// spectators receive the public table info on this channel, after WatchDelay
+	ToWatcherChan chan *ppb.GameInfo
+	WatchDelay    time.Duration
+
+	// waitlisted players receive their place in the queue and seat offers on this channel
+	ToWaitlistChan chan *ppb.WaitlistUpdate
+
 	// use this channel to send back and error to the grpc server on the initial subscription
 	ResultC chan PlayerActionResult
 
@@ -34,6 +49,65 @@ func NewPlayerAction(ctx context.Context, action ppb.PlayerAction, opts *ppb.Act
 	}
 }
 
+// NewWatchAction makes a new action to watch a table as a spectator
+func NewWatchAction(ctx context.Context, ci *ppb.ClientInfo, delay time.Duration, watcherChan chan *ppb.GameInfo, resultc chan PlayerActionResult) PlayerAction {
+	return PlayerAction{
+		Action:        ppb.PlayerAction_PlayerActionWatch,
+		ClientInfo:    ci,
+		ToWatcherChan: watcherChan,
+		WatchDelay:    delay,
+		ResultC:       resultc,
+		Ctx:           ctx,
+	}
+}
+
+// NewListTablesAction makes a new action to list the tables matching the filter
+func NewListTablesAction(ctx context.Context, ci *ppb.ClientInfo, filter *ppb.TableFilter, resultc chan PlayerActionResult) PlayerAction {
+	return PlayerAction{
+		Action:      ppb.PlayerAction_PlayerActionListTables,
+		ClientInfo:  ci,
+		TableFilter: filter,
+		ResultC:     resultc,
+		Ctx:         ctx,
+	}
+}
+
+// NewCreateTableAction makes a new action to create a table
+func NewCreateTableAction(ctx context.Context, ci *ppb.ClientInfo, config *ppb.TableConfig, resultc chan PlayerActionResult) PlayerAction {
+	return PlayerAction{
+		Action:      ppb.PlayerAction_PlayerActionCreateTable,
+		ClientInfo:  ci,
+		TableConfig: config,
+		ResultC:     resultc,
+		Ctx:         ctx,
+	}
+}
+
+// NewUpdateInvitesAction makes a new action to change the invites of a private table
+func NewUpdateInvitesAction(ctx context.Context, ci *ppb.ClientInfo, invite, revoke []string, resultc chan PlayerActionResult) PlayerAction {
+	return PlayerAction{
+		Action:     ppb.PlayerAction_PlayerActionUpdateInvites,
+		ClientInfo: ci,
+		Invite:     invite,
+		Revoke:     revoke,
+		ResultC:    resultc,
+		Ctx:        ctx,
+	}
+}
+
+// NewJoinWaitlistAction makes a new action to queue for a seat at the table in ci, or any table matching the filter
+func NewJoinWaitlistAction(ctx context.Context, ci *ppb.ClientInfo, filter *ppb.TableFilter, opts *ppb.ActionOpts, waitlistChan chan *ppb.WaitlistUpdate, resultc chan PlayerActionResult) PlayerAction {
+	return PlayerAction{
+		Action:         ppb.PlayerAction_PlayerActionJoinWaitlist,
+		ClientInfo:     ci,
+		Opts:           opts,
+		TableFilter:    filter,
+		ToWaitlistChan: waitlistChan,
+		ResultC:        resultc,
+		Ctx:            ctx,
+	}
+}
+
 // PlayerActionResult is the result from the manager to the grpc server
 type PlayerActionResult struct {
 	Result interface{}
@@ -76,6 +150,8 @@ func (a TableAction) String() string {
 		return ""
 	case ActionBet:
 		return "Bet"
+	case ActionAllIn:
+		return "AllIn"
 	case ActionBuyIn:
 		return "Buyin"
 	case ActionCheck:
@@ -86,6 +162,16 @@ func (a TableAction) String() string {
 		return "Fold"
 	case ActionDisconnect:
 		return "Disconnect"
+	case ActionStraddle:
+		return "Straddle"
+	case ActionPostAnte:
+		return "Ante"
+	case ActionPostSmallBlind:
+		return "SmallBlind"
+	case ActionPostBigBlind:
+		return "BigBlind"
+	case ActionPostDeadBlind:
+		return "DeadBlind"
 	}
 	return ""
 }
@@ -126,4 +212,49 @@ const (
 
 	// ActionDisconnect is triggered on client disconnect
 	ActionDisconnect
+
+	// ActionReleasePlayer removes a tournament player from the table to move them to another table
+	ActionReleasePlayer
+
+	// ActionSeatMovedPlayer seats a tournament player moved from another table
+	ActionSeatMovedPlayer
+
+	// ActionWatch adds a spectator to the table
+	ActionWatch
+
+	// ActionAutoRebuy sets the player's auto-rebuy
+	ActionAutoRebuy
+
+	// ActionLeaveTable stands the player up after the current hand, without disconnecting
+	ActionLeaveTable
+
+	// ActionSitOut keeps the player's seat without dealing the player in
+	ActionSitOut
+
+	// ActionSitIn deals a player sitting out back in
+	ActionSitIn
+
+	// ActionChangeSeat moves the player to an open seat between hands
+	ActionChangeSeat
+
+	// ActionStraddle opts the player in or out of straddling, and is the last action of a posted straddle
+	ActionStraddle
+
+	// ActionPostAnte is the last action of a player that posted an ante
+	ActionPostAnte
+
+	// ActionPostSmallBlind is the last action of a player that posted the small blind
+	ActionPostSmallBlind
+
+	// ActionPostBigBlind is the last action of a player that posted the big blind
+	ActionPostBigBlind
+
+	// ActionPostDeadBlind is the last action of a player that posted missed blinds
+	ActionPostDeadBlind
+
+	// ActionPreAction queues an action for the player's next turn
+	ActionPreAction
+
+	// ActionSnapshot sends the player a fresh snapshot of the table, after the player missed events
+	ActionSnapshot
 )
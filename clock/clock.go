@@ -0,0 +1,155 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time to the tables, the acks and the manager
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	// After returns a channel that receives the time once d has passed
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker sends the time on C every period
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Real returns the wall clock
+func Real() Clock {
+	return realClock{}
+}
+
+// OrReal returns c, or the wall clock if c is nil
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real()
+	}
+	return c
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	t *time.Ticker
+}
+
+func (r realTicker) C() <-chan time.Time { return r.t.C }
+func (r realTicker) Stop()               { r.t.Stop() }
+
+// Fake is a clock that only moves when told to, for tests
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter is a pending After, or a ticker
+type fakeWaiter struct {
+	at     time.Time
+	period time.Duration
+	c      chan time.Time
+}
+
+// NewFake returns a fake clock set to now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake time
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Since returns the fake time elapsed since t
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// After returns a channel that receives the fake time once the clock is advanced by d
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	return f.add(d, 0).c
+}
+
+// NewTicker returns a ticker that ticks as the clock is advanced
+// Like a real ticker, ticks are dropped if the receiver falls behind.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	return &fakeTicker{f: f, w: f.add(d, d)}
+}
+
+// Advance moves the clock forward by d, firing the timers and tickers that are due
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	waiters := f.waiters[:0]
+	for _, w := range f.waiters {
+		for !w.at.After(f.now) {
+			select {
+			case w.c <- w.at:
+			default:
+			}
+			if w.period == 0 {
+				break
+			}
+			w.at = w.at.Add(w.period)
+		}
+		if w.period > 0 || w.at.After(f.now) {
+			waiters = append(waiters, w)
+		}
+	}
+	f.waiters = waiters
+}
+
+// add registers a waiter that fires d from now, and then every period if it is not 0
+func (f *Fake) add(d, period time.Duration) *fakeWaiter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{
+		at:     f.now.Add(d),
+		period: period,
+		c:      make(chan time.Time, 1),
+	}
+	f.waiters = append(f.waiters, w)
+	return w
+}
+
+// remove unregisters the waiter
+func (f *Fake) remove(w *fakeWaiter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, o := range f.waiters {
+		if o == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type fakeTicker struct {
+	f *Fake
+	w *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.w.c }
+func (t *fakeTicker) Stop()               { t.f.remove(t.w) }
@@ -0,0 +1,60 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	ticker := c.NewTicker(time.Second)
+	after := c.After(time.Second * 3)
+
+	fired := func(ch <-chan time.Time) bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+
+	tests := []struct {
+		name      string
+		advance   time.Duration
+		wantTick  bool
+		wantAfter bool
+		wantSince time.Duration
+	}{
+		{name: "not yet", advance: time.Millisecond * 500, wantSince: time.Millisecond * 500},
+		{name: "tick", advance: time.Millisecond * 500, wantTick: true, wantSince: time.Second},
+		{name: "ticks missed by a slow receiver are dropped", advance: time.Second * 5, wantTick: true, wantAfter: true, wantSince: time.Second * 6},
+		{name: "after fires once", advance: time.Second * 5, wantTick: true, wantSince: time.Second * 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.Advance(tt.advance)
+
+			if got := fired(ticker.C()); got != tt.wantTick {
+				t.Errorf("tick = %v, want %v", got, tt.wantTick)
+			}
+			if got := fired(ticker.C()); got {
+				t.Errorf("second tick = %v, want false", got)
+			}
+			if got := fired(after); got != tt.wantAfter {
+				t.Errorf("after = %v, want %v", got, tt.wantAfter)
+			}
+			if got := c.Since(start); got != tt.wantSince {
+				t.Errorf("Since() = %v, want %v", got, tt.wantSince)
+			}
+		})
+	}
+
+	ticker.Stop()
+	c.Advance(time.Second)
+	if fired(ticker.C()) {
+		t.Errorf("stopped ticker ticked")
+	}
+}
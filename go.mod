@@ -34,6 +34,7 @@ require (
 	github.com/uber/jaeger-client-go v2.25.0+incompatible
 	github.com/uber/jaeger-lib v2.4.0+incompatible
 	github.com/wayneashleyberry/terminal-dimensions v1.0.0 // indirect
+	go.etcd.io/bbolt v1.3.5
 	go.opencensus.io v0.22.5
 	golang.org/x/net v0.0.0-20201224014010-6772e930b67b // indirect
 	golang.org/x/oauth2 v0.0.0-20201208152858-08078c50e5b5
@@ -0,0 +1,246 @@
+// Package handhistory records every hand played at a table as a structured event log
+package handhistory
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DanTulovsky/deck"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// lastHandNumber makes hand numbers unique across all tables, and very likely across restarts
+var lastHandNumber = time.Now().Unix() * 1000
+
+// EventType is the kind of event recorded in a hand
+type EventType int
+
+const (
+	// EventAnte is a posted ante
+	EventAnte EventType = iota
+	// EventSmallBlind is a posted small blind
+	EventSmallBlind
+	// EventBigBlind is a posted big blind
+	EventBigBlind
+	// EventHoleCards are the hole cards dealt to a player
+	EventHoleCards
+	// EventStreet are the cards dealt to the board at the start of a betting round
+	EventStreet
+	// EventFold is a fold
+	EventFold
+	// EventCheck is a check
+	EventCheck
+	// EventCall is a call
+	EventCall
+	// EventBet is the first bet of a betting round
+	EventBet
+	// EventRaise is a raise of an existing bet
+	EventRaise
+	// EventUncalled is a bet no one called, returned to the player
+	EventUncalled
+	// EventShow is a player showing their hand at showdown
+	EventShow
+	// EventCollect is a player collecting (part of) a pot
+	EventCollect
+	// EventDeadBlind are the blinds missed while sitting out, posted as dead money
+	EventDeadBlind
+	// EventStraddle is a voluntary blind posted by the player under the gun
+	EventStraddle
+)
+
+func (e EventType) String() string {
+	switch e {
+	case EventAnte:
+		return "Ante"
+	case EventSmallBlind:
+		return "SmallBlind"
+	case EventBigBlind:
+		return "BigBlind"
+	case EventHoleCards:
+		return "HoleCards"
+	case EventStreet:
+		return "Street"
+	case EventFold:
+		return "Fold"
+	case EventCheck:
+		return "Check"
+	case EventCall:
+		return "Call"
+	case EventBet:
+		return "Bet"
+	case EventRaise:
+		return "Raise"
+	case EventUncalled:
+		return "Uncalled"
+	case EventShow:
+		return "Show"
+	case EventCollect:
+		return "Collect"
+	case EventDeadBlind:
+		return "DeadBlind"
+	case EventStraddle:
+		return "Straddle"
+	}
+	return ""
+}
+
+// Event is one thing that happened during a hand
+type Event struct {
+	Type EventType
+	// the betting round the event happened in
+	Street   ppb.GameState
+	PlayerID id.PlayerID
+
+	// Amount is the money put in by the player, returned to the player (uncalled) or collected from a pot
+	Amount int64
+	// RaiseTo is the player's total bet this round after a bet or raise
+	RaiseTo int64
+	AllIn   bool
+
+	// Cards are the hole cards, the cards shown or the cards dealt to the board
+	Cards []deck.Card
+	// Combo is the shown hand
+	Combo string
+	// Pot is the pot collected, 0 for the main pot and 1.. for the side pots
+	Pot int
+}
+
+// Seat is a player sitting in the hand
+type Seat struct {
+	// Position is the table position, starting at 0
+	Position int
+	PlayerID id.PlayerID
+	Name     string
+	// Stack is the stack at the start of the hand
+	Stack int64
+}
+
+// Hand is the record of a single hand
+type Hand struct {
+	Number    int64
+	TableID   id.TableID
+	TableName string
+	Start     time.Time
+
+	Variant          ppb.GameVariant
+	BettingStructure ppb.BettingStructure
+	// Tournament is true for hands played with tournament chips
+	Tournament bool
+	MaxPlayers int
+
+	SmallBlind, BigBlind, Ante int64
+	// BigBlindAnte is true if the big blind posted the antes of every player
+	BigBlindAnte bool
+	// Button is the table position of the button
+	Button int
+	// Seed shuffled the deck, the hand is dealt again from it by a replay
+	Seed int64
+
+	Seats  []Seat
+	Events []Event
+	Board  []deck.Card
+}
+
+// New starts recording a new hand
+func New(tableID id.TableID, tableName string, start time.Time) *Hand {
+	return &Hand{
+		Number:    atomic.AddInt64(&lastHandNumber, 1),
+		TableID:   tableID,
+		TableName: tableName,
+		Start:     start,
+	}
+}
+
+// AddSeat adds a player sitting in the hand
+func (h *Hand) AddSeat(s Seat) {
+	h.Seats = append(h.Seats, s)
+}
+
+// Seat returns the seat of the player
+func (h *Hand) Seat(p id.PlayerID) (Seat, bool) {
+	for _, s := range h.Seats {
+		if s.PlayerID == p {
+			return s, true
+		}
+	}
+	return Seat{}, false
+}
+
+// Record records an event
+func (h *Hand) Record(e Event) {
+	if e.Type == EventStreet {
+		h.Board = append(h.Board, e.Cards...)
+	}
+	h.Events = append(h.Events, e)
+}
+
+// EventsOf returns the events of the given type
+func (h *Hand) EventsOf(t EventType) []Event {
+	var events []Event
+	for _, e := range h.Events {
+		if e.Type == t {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// TotalPot returns all the money collected from the pots
+func (h *Hand) TotalPot() int64 {
+	var total int64
+	for _, e := range h.EventsOf(EventCollect) {
+		total += e.Amount
+	}
+	return total
+}
+
+// Store keeps the most recent hands in memory
+type Store struct {
+	mu    sync.Mutex
+	max   int
+	hands []*Hand
+}
+
+// NewStore returns a store that keeps up to max hands
+func NewStore(max int) *Store {
+	return &Store{
+		max: max,
+	}
+}
+
+// Add adds a finished hand, dropping the oldest one if the store is full
+func (s *Store) Add(h *Hand) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hands = append(s.hands, h)
+	if len(s.hands) > s.max {
+		s.hands = s.hands[len(s.hands)-s.max:]
+	}
+}
+
+// Hands returns the stored hands, oldest first
+func (s *Store) Hands() []*Hand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hands := make([]*Hand, len(s.hands))
+	copy(hands, s.hands)
+	return hands
+}
+
+// Get returns the hand with the given number
+func (s *Store) Get(number int64) (*Hand, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, h := range s.hands {
+		if h.Number == number {
+			return h, true
+		}
+	}
+	return nil, false
+}
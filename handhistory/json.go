@@ -0,0 +1,35 @@
+package handhistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteJSON writes the hand as a single line of JSON, with everything needed to replay it
+// Unlike the PokerStars format, it keeps the deck seed, the player IDs and every event as recorded.
+func WriteJSON(w io.Writer, h *Hand) error {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// ReadJSON reads the hands written by WriteJSON, in the order they were written
+func ReadJSON(r io.Reader) ([]*Hand, error) {
+	var hands []*Hand
+
+	dec := json.NewDecoder(r)
+	for {
+		h := &Hand{}
+		if err := dec.Decode(h); err == io.EOF {
+			return hands, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to read hand %d: %v", len(hands)+1, err)
+		}
+		hands = append(hands, h)
+	}
+}
@@ -0,0 +1,56 @@
+package handhistory
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/deck"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestJSON(t *testing.T) {
+	var hands []*Hand
+	for i := 0; i < 2; i++ {
+		h := New("t1", "Table 1", time.Date(2020, 12, 1, 20, 0, i, 0, time.UTC))
+		h.Variant = ppb.GameVariant_GameVariantPotLimitOmaha
+		h.BettingStructure = ppb.BettingStructure_BettingStructurePotLimit
+		h.SmallBlind, h.BigBlind, h.Ante, h.BigBlindAnte = 5, 10, 1, true
+		h.Seed = int64(42 + i)
+		h.AddSeat(Seat{Position: 3, PlayerID: "a", Name: "alice", Stack: 1000})
+		h.Record(Event{Type: EventStreet, Street: ppb.GameState_GameStatePlayingFlop, Cards: []deck.Card{
+			deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ace), deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Two)}})
+		h.Record(Event{Type: EventRaise, Street: ppb.GameState_GameStatePlayingFlop, PlayerID: "a", Amount: 25, RaiseTo: 30, AllIn: true})
+		hands = append(hands, h)
+	}
+
+	var b bytes.Buffer
+	for _, h := range hands {
+		if err := WriteJSON(&b, h); err != nil {
+			t.Fatalf("WriteJSON() = %v", err)
+		}
+	}
+	if lines := strings.Count(b.String(), "\n"); lines != 2 {
+		t.Errorf("wrote %d lines, want one per hand", lines)
+	}
+
+	got, err := ReadJSON(&b)
+	if err != nil {
+		t.Fatalf("ReadJSON() = %v", err)
+	}
+	if len(got) != len(hands) {
+		t.Fatalf("ReadJSON() read %d hands, want %d", len(got), len(hands))
+	}
+	for i := range hands {
+		if !reflect.DeepEqual(got[i], hands[i]) {
+			t.Errorf("hand %d = %+v, want %+v", i, got[i], hands[i])
+		}
+	}
+
+	if _, err := ReadJSON(strings.NewReader("{\"Number\": 1}\n{")); err == nil {
+		t.Errorf("ReadJSON() of a cut off hand = nil, want error")
+	}
+}
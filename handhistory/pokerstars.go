@@ -0,0 +1,337 @@
+package handhistory
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/DanTulovsky/deck"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// pokerStarsTimeZone is the time zone PokerStars hand histories are written in
+var pokerStarsTimeZone = func() *time.Location {
+	if l, err := time.LoadLocation("America/New_York"); err == nil {
+		return l
+	}
+	return nil
+}()
+
+var (
+	pokerStarsRanks = map[ppb.CardRank]string{
+		ppb.CardRank_Two: "2", ppb.CardRank_Three: "3", ppb.CardRank_Four: "4", ppb.CardRank_Five: "5",
+		ppb.CardRank_Six: "6", ppb.CardRank_Seven: "7", ppb.CardRank_Eight: "8", ppb.CardRank_Nine: "9",
+		ppb.CardRank_Ten: "T", ppb.CardRank_Jack: "J", ppb.CardRank_Queen: "Q", ppb.CardRank_King: "K",
+		ppb.CardRank_Ace: "A",
+	}
+	pokerStarsSuits = map[ppb.CardSuit]string{
+		ppb.CardSuit_Spade: "s", ppb.CardSuit_Club: "c", ppb.CardSuit_Diamond: "d", ppb.CardSuit_Heart: "h",
+	}
+)
+
+// WritePokerStars writes the hand in the PokerStars hand history text format
+// Only the hole cards of hero are listed as dealt, everyone else's cards show up at showdown.
+// If hero is empty, the hole cards of all players are listed.
+func WritePokerStars(w io.Writer, h *Hand, hero id.PlayerID) error {
+	ps := &pokerStarsWriter{h: h}
+
+	ps.header()
+	ps.seats()
+	ps.actions(hero)
+	ps.summary()
+	ps.line("")
+	ps.line("")
+
+	_, err := io.WriteString(w, ps.out.String())
+	return err
+}
+
+// pokerStarsWriter builds the text of a single hand
+type pokerStarsWriter struct {
+	h   *Hand
+	out strings.Builder
+}
+
+func (ps *pokerStarsWriter) line(format string, args ...interface{}) {
+	ps.out.WriteString(fmt.Sprintf(format, args...))
+	ps.out.WriteString("\n")
+}
+
+// money formats an amount, tournament chips have no currency
+func (ps *pokerStarsWriter) money(amount int64) string {
+	if ps.h.Tournament {
+		return fmt.Sprintf("%d", amount)
+	}
+	return fmt.Sprintf("$%d", amount)
+}
+
+// name returns the player's name
+func (ps *pokerStarsWriter) name(p id.PlayerID) string {
+	if s, ok := ps.h.Seat(p); ok {
+		return s.Name
+	}
+	return p.String()
+}
+
+func (ps *pokerStarsWriter) header() {
+	h := ps.h
+
+	start := h.Start
+	zone := "UTC"
+	if pokerStarsTimeZone != nil {
+		start = start.In(pokerStarsTimeZone)
+		zone = "ET"
+	} else {
+		start = start.UTC()
+	}
+
+	stakes := fmt.Sprintf("%v/%v", ps.money(h.SmallBlind), ps.money(h.BigBlind))
+	if !h.Tournament {
+		stakes += " USD"
+	}
+
+	ps.line("PokerStars Hand #%d:  %v (%v) - %v %v", h.Number, pokerStarsGame(h.Variant, h.BettingStructure), stakes, start.Format("2006/01/02 15:04:05"), zone)
+	ps.line("Table '%v' %d-max Seat #%d is the button", h.TableName, h.MaxPlayers, h.Button+1)
+}
+
+func (ps *pokerStarsWriter) seats() {
+	for _, s := range ps.h.Seats {
+		ps.line("Seat %d: %v (%v in chips)", s.Position+1, s.Name, ps.money(s.Stack))
+	}
+}
+
+func (ps *pokerStarsWriter) actions(hero id.PlayerID) {
+	h := ps.h
+
+	multiplePots := false
+	for _, e := range h.EventsOf(EventCollect) {
+		if e.Pot > 0 {
+			multiplePots = true
+		}
+	}
+
+	// the highest bet this betting round, used to print raise increments
+	var currentBet int64
+	var holeCards, showdown bool
+	var board []deck.Card
+
+	for _, e := range h.Events {
+		name := ps.name(e.PlayerID)
+
+		allin := ""
+		if e.AllIn {
+			allin = " and is all-in"
+		}
+
+		switch e.Type {
+		case EventAnte:
+			ps.line("%v: posts the ante %v%v", name, ps.money(e.Amount), allin)
+		case EventDeadBlind:
+			ps.line("%v: posts dead blind %v%v", name, ps.money(e.Amount), allin)
+		case EventSmallBlind:
+			ps.line("%v: posts small blind %v%v", name, ps.money(e.Amount), allin)
+			currentBet = max64(currentBet, e.Amount)
+		case EventBigBlind:
+			ps.line("%v: posts big blind %v%v", name, ps.money(e.Amount), allin)
+			currentBet = max64(currentBet, e.Amount)
+		case EventStraddle:
+			ps.line("%v: posts straddle %v%v", name, ps.money(e.Amount), allin)
+			currentBet = max64(currentBet, e.Amount)
+
+		case EventHoleCards:
+			if !holeCards {
+				ps.line("*** HOLE CARDS ***")
+				holeCards = true
+			}
+			if hero == "" || hero == e.PlayerID {
+				ps.line("Dealt to %v [%v]", name, pokerStarsCards(e.Cards))
+			}
+
+		case EventStreet:
+			currentBet = 0
+			switch e.Street {
+			case ppb.GameState_GameStatePlayingFlop:
+				ps.line("*** FLOP *** [%v]", pokerStarsCards(e.Cards))
+			case ppb.GameState_GameStatePlayingTurn:
+				ps.line("*** TURN *** [%v] [%v]", pokerStarsCards(board), pokerStarsCards(e.Cards))
+			case ppb.GameState_GameStatePlayingRiver:
+				ps.line("*** RIVER *** [%v] [%v]", pokerStarsCards(board), pokerStarsCards(e.Cards))
+			}
+			board = append(board, e.Cards...)
+
+		case EventFold:
+			ps.line("%v: folds", name)
+		case EventCheck:
+			ps.line("%v: checks", name)
+		case EventCall:
+			ps.line("%v: calls %v%v", name, ps.money(e.Amount), allin)
+		case EventBet:
+			ps.line("%v: bets %v%v", name, ps.money(e.Amount), allin)
+			currentBet = e.RaiseTo
+		case EventRaise:
+			ps.line("%v: raises %v to %v%v", name, ps.money(e.RaiseTo-currentBet), ps.money(e.RaiseTo), allin)
+			currentBet = e.RaiseTo
+
+		case EventUncalled:
+			ps.line("Uncalled bet (%v) returned to %v", ps.money(e.Amount), name)
+
+		case EventShow:
+			if !showdown {
+				ps.line("*** SHOW DOWN ***")
+				showdown = true
+			}
+			ps.line("%v: shows [%v] (%v)", name, pokerStarsCards(e.Cards), e.Combo)
+
+		case EventCollect:
+			ps.line("%v collected %v from %v", name, ps.money(e.Amount), pokerStarsPot(e.Pot, multiplePots))
+		}
+	}
+}
+
+func (ps *pokerStarsWriter) summary() {
+	h := ps.h
+
+	ps.line("*** SUMMARY ***")
+
+	var pots []int64
+	for _, e := range h.EventsOf(EventCollect) {
+		for len(pots) <= e.Pot {
+			pots = append(pots, 0)
+		}
+		pots[e.Pot] += e.Amount
+	}
+
+	total := fmt.Sprintf("Total pot %v", ps.money(h.TotalPot()))
+	if len(pots) > 1 {
+		total += fmt.Sprintf(" Main pot %v.", ps.money(pots[0]))
+		for i := 1; i < len(pots); i++ {
+			total += fmt.Sprintf(" Side pot-%d %v.", i, ps.money(pots[i]))
+		}
+	}
+	ps.line("%v | Rake %v", total, ps.money(0))
+
+	if len(h.Board) > 0 {
+		ps.line("Board [%v]", pokerStarsCards(h.Board))
+	}
+
+	for _, s := range h.Seats {
+		ps.line("Seat %d: %v%v %v", s.Position+1, s.Name, ps.role(s), ps.outcome(s))
+	}
+}
+
+// role returns the button and blind markers of the seat
+func (ps *pokerStarsWriter) role(s Seat) string {
+	var roles []string
+
+	if s.Position == ps.h.Button {
+		roles = append(roles, "(button)")
+	}
+	for _, e := range ps.h.EventsOf(EventSmallBlind) {
+		if e.PlayerID == s.PlayerID {
+			roles = append(roles, "(small blind)")
+		}
+	}
+	for _, e := range ps.h.EventsOf(EventBigBlind) {
+		if e.PlayerID == s.PlayerID {
+			roles = append(roles, "(big blind)")
+		}
+	}
+
+	if len(roles) == 0 {
+		return ""
+	}
+	return " " + strings.Join(roles, " ")
+}
+
+// outcome returns how the hand ended for the seat
+func (ps *pokerStarsWriter) outcome(s Seat) string {
+	var won int64
+	for _, e := range ps.h.EventsOf(EventCollect) {
+		if e.PlayerID == s.PlayerID {
+			won += e.Amount
+		}
+	}
+
+	for _, e := range ps.h.EventsOf(EventFold) {
+		if e.PlayerID == s.PlayerID {
+			if e.Street <= ppb.GameState_GameStatePlayingPreFlop {
+				return "folded before Flop"
+			}
+			return fmt.Sprintf("folded on the %v", pokerStarsStreet(e.Street))
+		}
+	}
+
+	for _, e := range ps.h.EventsOf(EventShow) {
+		if e.PlayerID == s.PlayerID {
+			if won > 0 {
+				return fmt.Sprintf("showed [%v] and won (%v) with %v", pokerStarsCards(e.Cards), ps.money(won), e.Combo)
+			}
+			return fmt.Sprintf("showed [%v] and lost with %v", pokerStarsCards(e.Cards), e.Combo)
+		}
+	}
+
+	if won > 0 {
+		return fmt.Sprintf("collected (%v)", ps.money(won))
+	}
+	return "mucked"
+}
+
+// pokerStarsGame returns the game name used in the hand header
+func pokerStarsGame(v ppb.GameVariant, s ppb.BettingStructure) string {
+	game := "Hold'em"
+	if v == ppb.GameVariant_GameVariantPotLimitOmaha {
+		game = "Omaha"
+	}
+
+	switch s {
+	case ppb.BettingStructure_BettingStructurePotLimit:
+		return game + " Pot Limit"
+	case ppb.BettingStructure_BettingStructureFixedLimit:
+		return game + " Limit"
+	default:
+		return game + " No Limit"
+	}
+}
+
+// pokerStarsStreet returns the street name used in the summary
+func pokerStarsStreet(s ppb.GameState) string {
+	switch s {
+	case ppb.GameState_GameStatePlayingFlop:
+		return "Flop"
+	case ppb.GameState_GameStatePlayingTurn:
+		return "Turn"
+	default:
+		return "River"
+	}
+}
+
+// pokerStarsPot returns the name of the pot
+func pokerStarsPot(pot int, multiplePots bool) string {
+	switch {
+	case !multiplePots:
+		return "pot"
+	case pot == 0:
+		return "main pot"
+	default:
+		return fmt.Sprintf("side pot-%d", pot)
+	}
+}
+
+// pokerStarsCards formats cards as, e.g., "Ah Td"
+func pokerStarsCards(cards []deck.Card) string {
+	s := make([]string, len(cards))
+	for i, c := range cards {
+		s[i] = pokerStarsRanks[c.GetRank()] + pokerStarsSuits[c.GetSuit()]
+	}
+	return strings.Join(s, " ")
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
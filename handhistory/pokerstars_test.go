@@ -0,0 +1,61 @@
+package handhistory
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/deck"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestWritePokerStars(t *testing.T) {
+	h := New("t1", "Table 1", time.Date(2020, 12, 1, 20, 0, 0, 0, time.UTC))
+	h.Variant = ppb.GameVariant_GameVariantTexasHoldem
+	h.BettingStructure = ppb.BettingStructure_BettingStructureNoLimit
+	h.MaxPlayers = 6
+	h.SmallBlind, h.BigBlind = 5, 10
+	h.Button = 0
+
+	h.AddSeat(Seat{Position: 0, PlayerID: "a", Name: "alice", Stack: 1000})
+	h.AddSeat(Seat{Position: 1, PlayerID: "b", Name: "bob", Stack: 500})
+
+	pre := ppb.GameState_GameStatePlayingPreFlop
+	h.Record(Event{Type: EventSmallBlind, Street: pre, PlayerID: "a", Amount: 5, RaiseTo: 5})
+	h.Record(Event{Type: EventBigBlind, Street: pre, PlayerID: "b", Amount: 10, RaiseTo: 10})
+	h.Record(Event{Type: EventHoleCards, Street: pre, PlayerID: "a", Cards: []deck.Card{
+		deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ace), deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Ten)}})
+	h.Record(Event{Type: EventHoleCards, Street: pre, PlayerID: "b", Cards: []deck.Card{
+		deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Two), deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Seven)}})
+	h.Record(Event{Type: EventRaise, Street: pre, PlayerID: "a", Amount: 25, RaiseTo: 30})
+	h.Record(Event{Type: EventFold, Street: pre, PlayerID: "b"})
+	h.Record(Event{Type: EventUncalled, Street: pre, PlayerID: "a", Amount: 20})
+	h.Record(Event{Type: EventCollect, Street: pre, PlayerID: "a", Amount: 20})
+
+	var b strings.Builder
+	if err := WritePokerStars(&b, h, "a"); err != nil {
+		t.Fatalf("WritePokerStars() = %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"Hold'em No Limit ($5/$10 USD)",
+		"Table 'Table 1' 6-max Seat #1 is the button",
+		"Seat 2: bob ($500 in chips)",
+		"bob: posts big blind $10",
+		"Dealt to alice [Ah Td]",
+		"alice: raises $20 to $30",
+		"Uncalled bet ($20) returned to alice",
+		"alice collected $20 from pot",
+		"Total pot $20 | Rake $0",
+		"Seat 2: bob (big blind) folded before Flop",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%v", want, out)
+		}
+	}
+	if strings.Contains(out, "Dealt to bob") {
+		t.Errorf("output shows the hole cards of a player other than hero:\n%v", out)
+	}
+}
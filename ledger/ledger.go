@@ -0,0 +1,298 @@
+// Package ledger records every movement of money and chips as a balanced, double-entry transaction
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	imbalances = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pepperpoker_ledger_imbalances_total",
+		Help: "number of unbalanced transactions and failed balance checks, should always be 0",
+	})
+)
+
+// AccountType is the kind of account
+type AccountType int
+
+const (
+	// AccountExternal is money outside of the game, in the user store
+	AccountExternal AccountType = iota
+	// AccountBank is a player's bank
+	AccountBank
+	// AccountStack is a player's stack at a table
+	AccountStack
+	// AccountPot is the pot at a table
+	AccountPot
+	// AccountRake is the rake taken at a table
+	AccountRake
+	// AccountPrizePool holds the buyins of a tournament until they are paid out
+	AccountPrizePool
+	// AccountChips issues the chips of a tournament, and takes them back from eliminated players
+	AccountChips
+)
+
+func (a AccountType) String() string {
+	switch a {
+	case AccountExternal:
+		return "external"
+	case AccountBank:
+		return "bank"
+	case AccountStack:
+		return "stack"
+	case AccountPot:
+		return "pot"
+	case AccountRake:
+		return "rake"
+	case AccountPrizePool:
+		return "prizepool"
+	case AccountChips:
+		return "chips"
+	}
+	return "unknown"
+}
+
+// Account is an account money moves in and out of
+type Account struct {
+	Type  AccountType
+	Owner string
+}
+
+func (a Account) String() string {
+	if a.Owner == "" {
+		return a.Type.String()
+	}
+	return fmt.Sprintf("%v:%v", a.Type, a.Owner)
+}
+
+// ParseAccount parses an account in the form returned by Account.String, such as "bank:<player id>"
+func ParseAccount(s string) (Account, error) {
+	name, owner := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		name, owner = s[:i], s[i+1:]
+	}
+
+	for t := AccountExternal; t <= AccountChips; t++ {
+		if t.String() == name {
+			return Account{Type: t, Owner: owner}, nil
+		}
+	}
+	return Account{}, fmt.Errorf("invalid account type [%v] in [%v]", name, s)
+}
+
+// External returns the account of money outside the game
+func External() Account {
+	return Account{Type: AccountExternal}
+}
+
+// Bank returns the bank account of the player
+func Bank(p id.PlayerID) Account {
+	return Account{Type: AccountBank, Owner: p.String()}
+}
+
+// Stack returns the stack account of the player
+func Stack(p id.PlayerID) Account {
+	return Account{Type: AccountStack, Owner: p.String()}
+}
+
+// Pot returns the pot account of the table
+func Pot(t id.TableID) Account {
+	return Account{Type: AccountPot, Owner: t.String()}
+}
+
+// Rake returns the rake account of the table
+func Rake(t id.TableID) Account {
+	return Account{Type: AccountRake, Owner: t.String()}
+}
+
+// PrizePool returns the prize pool account of the tournament
+func PrizePool(tournament string) Account {
+	return Account{Type: AccountPrizePool, Owner: tournament}
+}
+
+// Chips returns the chip issuing account of the tournament
+func Chips(tournament string) Account {
+	return Account{Type: AccountChips, Owner: tournament}
+}
+
+// Entry is one side of a transaction, a positive amount is a credit and a negative amount a debit
+type Entry struct {
+	Account Account
+	Amount  int64
+}
+
+// Transaction is a set of entries that add up to 0
+type Transaction struct {
+	ID      int64
+	Time    time.Time
+	TableID id.TableID
+	Memo    string
+	Entries []Entry
+}
+
+// Touches returns true if the transaction has an entry for the account
+func (tx Transaction) Touches(a Account) bool {
+	for _, e := range tx.Entries {
+		if e.Account == a {
+			return true
+		}
+	}
+	return false
+}
+
+func (tx Transaction) String() string {
+	var entries []string
+	for _, e := range tx.Entries {
+		entries = append(entries, fmt.Sprintf("%v %+d", e.Account, e.Amount))
+	}
+	return fmt.Sprintf("#%d [%v] %v: %v", tx.ID, tx.TableID, tx.Memo, strings.Join(entries, ", "))
+}
+
+// Ledger keeps the balances of all accounts and the most recent transactions
+type Ledger struct {
+	mu sync.Mutex
+
+	lastID   int64
+	balances map[Account]int64
+
+	// max is the number of transactions kept for audit queries, balances always cover all transactions
+	max          int
+	transactions []Transaction
+}
+
+// New returns a ledger that keeps up to max transactions for audit queries
+func New(max int) *Ledger {
+	return &Ledger{
+		max:      max,
+		balances: make(map[Account]int64),
+	}
+}
+
+// Transfer moves amount from one account to another
+func (l *Ledger) Transfer(table id.TableID, from, to Account, amount int64, memo string) error {
+	if amount < 0 {
+		return l.fail(fmt.Errorf("transfer of a negative amount (%v) from [%v] to [%v]", amount, from, to))
+	}
+	if amount == 0 {
+		return nil
+	}
+
+	return l.Record(Transaction{
+		TableID: table,
+		Memo:    memo,
+		Entries: []Entry{
+			{Account: from, Amount: -amount},
+			{Account: to, Amount: amount},
+		},
+	})
+}
+
+// Record records a transaction, the entries must add up to 0
+func (l *Ledger) Record(tx Transaction) error {
+	var sum int64
+	for _, e := range tx.Entries {
+		sum += e.Amount
+	}
+	if sum != 0 {
+		return l.fail(fmt.Errorf("unbalanced transaction (off by %v): %v", sum, tx))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastID++
+	tx.ID = l.lastID
+	if tx.Time.IsZero() {
+		tx.Time = time.Now()
+	}
+
+	for _, e := range tx.Entries {
+		l.balances[e.Account] += e.Amount
+	}
+
+	l.transactions = append(l.transactions, tx)
+	if len(l.transactions) > l.max {
+		l.transactions = l.transactions[len(l.transactions)-l.max:]
+	}
+	return nil
+}
+
+// Balance returns the balance of the account
+func (l *Ledger) Balance(a Account) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.balances[a]
+}
+
+// Total returns the sum of all balances, it is always 0 unless the ledger itself is broken
+func (l *Ledger) Total() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var total int64
+	for _, b := range l.balances {
+		total += b
+	}
+	return total
+}
+
+// Verify checks that the accounts have the expected balances
+func (l *Ledger) Verify(expected map[Account]int64) error {
+	l.mu.Lock()
+	var problems []string
+	for a, want := range expected {
+		if got := l.balances[a]; got != want {
+			problems = append(problems, fmt.Sprintf("[%v] is %v in the ledger, but %v at the table", a, got, want))
+		}
+	}
+	l.mu.Unlock()
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return l.fail(fmt.Errorf("chips are not conserved: %v", strings.Join(problems, "; ")))
+}
+
+// Query selects transactions in an audit; empty fields match everything
+type Query struct {
+	Account *Account
+	TableID id.TableID
+	Since   time.Time
+}
+
+// Audit returns the kept transactions matching the query, oldest first
+func (l *Ledger) Audit(q Query) []Transaction {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var txs []Transaction
+	for _, tx := range l.transactions {
+		if q.Account != nil && !tx.Touches(*q.Account) {
+			continue
+		}
+		if q.TableID != "" && tx.TableID != q.TableID {
+			continue
+		}
+		if !q.Since.IsZero() && tx.Time.Before(q.Since) {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// fail counts the error in the imbalance metric
+func (l *Ledger) fail(err error) error {
+	imbalances.Inc()
+	return err
+}
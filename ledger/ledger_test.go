@@ -0,0 +1,77 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+)
+
+func TestLedger(t *testing.T) {
+	l := New(3)
+	table := id.TableID("t1")
+	a, b := id.PlayerID("a"), id.PlayerID("b")
+
+	transfers := []struct {
+		from, to Account
+		amount   int64
+	}{
+		{External(), Bank(a), 1000},
+		{External(), Bank(b), 1000},
+		{Bank(a), Stack(a), 200},
+		{Bank(b), Stack(b), 200},
+		{Stack(a), Pot(table), 50},
+		{Stack(b), Pot(table), 50},
+		{Pot(table), Rake(table), 5},
+		{Pot(table), Stack(b), 95},
+	}
+	for _, tr := range transfers {
+		if err := l.Transfer(table, tr.from, tr.to, tr.amount, "test"); err != nil {
+			t.Fatalf("Transfer(%v, %v, %v) = %v", tr.from, tr.to, tr.amount, err)
+		}
+	}
+
+	want := map[Account]int64{
+		Bank(a):     800,
+		Stack(a):    150,
+		Stack(b):    245,
+		Pot(table):  0,
+		Rake(table): 5,
+		External():  -2000,
+	}
+	if err := l.Verify(want); err != nil {
+		t.Errorf("Verify() = %v", err)
+	}
+	if l.Total() != 0 {
+		t.Errorf("Total() = %v, want 0", l.Total())
+	}
+
+	// a stack that does not match the ledger
+	if err := l.Verify(map[Account]int64{Stack(a): 151}); err == nil {
+		t.Errorf("Verify() of a wrong stack = nil, want error")
+	}
+
+	if err := l.Record(Transaction{Entries: []Entry{{Account: Pot(table), Amount: -10}, {Account: Stack(a), Amount: 11}}}); err == nil {
+		t.Errorf("Record() of an unbalanced transaction = nil, want error")
+	}
+	if err := l.Transfer(table, Stack(a), Pot(table), -1, "test"); err == nil {
+		t.Errorf("Transfer() of a negative amount = nil, want error")
+	}
+
+	acc := Stack(b)
+	txs := l.Audit(Query{Account: &acc})
+	if len(txs) != 2 || txs[0].ID != 6 || txs[1].ID != 8 {
+		t.Errorf("Audit() of the kept transactions touching %v = %v, want #6 and #8", acc, txs)
+	}
+}
+
+func TestParseAccount(t *testing.T) {
+	for _, a := range []Account{External(), Bank("p1"), Pot("t1"), PrizePool("sng")} {
+		got, err := ParseAccount(a.String())
+		if err != nil || got != a {
+			t.Errorf("ParseAccount(%q) = %v, %v, want %v", a.String(), got, err, a)
+		}
+	}
+	if _, err := ParseAccount("wallet:p1"); err == nil {
+		t.Errorf("ParseAccount() of an unknown type = nil, want error")
+	}
+}
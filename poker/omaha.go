@@ -0,0 +1,65 @@
+package poker
+
+import (
+	"log"
+
+	"github.com/DanTulovsky/deck"
+)
+
+const (
+	// OmahaHoleCards is the number of hole cards each player is dealt in Omaha
+	OmahaHoleCards = 4
+
+	// omahaHoleUsed is the number of hole cards that must be used in an Omaha hand
+	omahaHoleUsed = 2
+	// omahaBoardUsed is the number of board cards that must be used in an Omaha hand
+	omahaBoardUsed = 3
+)
+
+// BestOmahaCombo returns the best hand made from exactly two of the hole cards and exactly three of the board cards
+func BestOmahaCombo(hole, board []deck.Card) *Hand {
+	if len(hole) < omahaHoleUsed || len(board) < omahaBoardUsed {
+		log.Fatalf("need at least %d hole and %d board cards for BestOmahaCombo, have %d and %d",
+			omahaHoleUsed, omahaBoardUsed, len(hole), len(board))
+	}
+
+	var best *Hand
+
+	for _, h := range combinations(hole, omahaHoleUsed) {
+		for _, b := range combinations(board, omahaBoardUsed) {
+			cards := make([]deck.Card, 0, omahaHoleUsed+omahaBoardUsed)
+			cards = append(cards, h...)
+			cards = append(cards, b...)
+
+			hand := BestCombo(cards...)
+			hand.SortCards()
+
+			if best == nil || hand.CompareTo(best) > 0 {
+				best = hand
+			}
+		}
+	}
+
+	return best
+}
+
+// combinations returns all the k sized subsets of cards, each in a newly allocated slice
+func combinations(cards []deck.Card, k int) [][]deck.Card {
+	result := [][]deck.Card{}
+
+	var pick func(start int, chosen []deck.Card)
+	pick = func(start int, chosen []deck.Card) {
+		if len(chosen) == k {
+			c := make([]deck.Card, k)
+			copy(c, chosen)
+			result = append(result, c)
+			return
+		}
+		for i := start; i < len(cards); i++ {
+			pick(i+1, append(chosen, cards[i]))
+		}
+	}
+	pick(0, make([]deck.Card, 0, k))
+
+	return result
+}
@@ -0,0 +1,109 @@
+package poker
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/deck"
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestBestOmahaCombo(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []deck.Card
+		board []deck.Card
+		want  Combo
+	}{
+		{
+			// four hearts on board, but only one heart in the hole
+			name: "no flush with one suited hole card",
+			hole: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Nine),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Seven),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Four),
+			},
+			board: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_King),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ten),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Six),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Two),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Jack),
+			},
+			want: HighCard,
+		},
+		{
+			// four aces on board, but only three board cards can be used
+			name: "no quads from the board",
+			hole: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_King),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Queen),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Seven),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Four),
+			},
+			board: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Two),
+			},
+			want: ThreeOfAKind,
+		},
+		{
+			name: "flush with two suited hole cards",
+			hole: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ace),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Nine),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Seven),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Four),
+			},
+			board: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_King),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ten),
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Six),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Two),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Jack),
+			},
+			want: Flush,
+		},
+		{
+			name: "straight using two hole and three board cards",
+			hole: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Eight),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Nine),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Two),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Two),
+			},
+			board: []deck.Card{
+				deck.NewCard(ppb.CardSuit_Heart, ppb.CardRank_Ten),
+				deck.NewCard(ppb.CardSuit_Diamond, ppb.CardRank_Jack),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Queen),
+				deck.NewCard(ppb.CardSuit_Club, ppb.CardRank_Three),
+				deck.NewCard(ppb.CardSuit_Spade, ppb.CardRank_Four),
+			},
+			want: Straight,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BestOmahaCombo(tt.hole, tt.board)
+			if got.Combo() != tt.want {
+				t.Errorf("BestOmahaCombo() = %v, want %v", got, tt.want)
+			}
+			if len(got.Cards()) != 5 {
+				t.Errorf("BestOmahaCombo() returned %d cards, want 5", len(got.Cards()))
+			}
+
+			var fromHole int
+			for _, c := range got.Cards() {
+				if deck.CardInList(c, tt.hole) {
+					fromHole++
+				}
+			}
+			if fromHole != 2 {
+				t.Errorf("BestOmahaCombo() used %d hole cards, want 2", fromHole)
+			}
+		})
+	}
+}
@@ -199,15 +199,17 @@ func (a SortByID) Less(i, j int) bool {
 //  [player2]
 // ]
 // Only players that have not folded are included
+// If a PlayerHand already has its Hand set (e.g. by BestOmahaCombo), it is used as is
 func BestHand(pls []*PlayerHand) []Winners {
 
 	winners := []Winners{}
 
 	// First get the best hand for all players that haven't folded (those passed in here)
 	for _, p := range pls {
-		h := BestCombo(p.Cards...)
-		h.SortCards()
-		p.Hand = h // save hand for each player
+		if p.Hand == nil {
+			p.Hand = BestCombo(p.Cards...) // save hand for each player
+		}
+		p.Hand.SortCards()
 	}
 
 	sort.Sort(sort.Reverse(SortByPlayerHands(pls)))
@@ -41,6 +41,15 @@ type Pot struct {
 	// Only set after Finalize is called
 	finalized bool
 	winnings  map[id.PlayerID]int64
+	awards    []SubpotAward
+}
+
+// SubpotAward describes how one subpot was split when the pot was finalized.
+type SubpotAward struct {
+	Total int64
+	// Contributors is the number of players that put money in the subpot.
+	Contributors int
+	Winnings     map[id.PlayerID]int64
 }
 
 // NewPot creates a new pot.
@@ -141,6 +150,7 @@ func (p *Pot) Add(player id.PlayerID, bet int64, allin bool) {
 
 // Finalize finalizes each player's winnings based on their hand rankings.
 func (p *Pot) Finalize(rankings []Winners) {
+	p.awards = nil
 	for _, s := range p.subpots {
 		if s.GetTotal() == 0 {
 			continue
@@ -167,17 +177,33 @@ func (p *Pot) Finalize(rankings []Winners) {
 		// Divide the subpot among the winners, awarding leftovers in order (hopefully, clockwise after button).
 		winning := s.GetTotal() / int64(len(winners))
 		remainder := s.GetTotal() - (winning * int64(len(winners)))
+		award := SubpotAward{
+			Total:        s.GetTotal(),
+			Contributors: len(s.bets),
+			Winnings:     make(map[id.PlayerID]int64),
+		}
 		for _, winner := range winners {
 			p.winnings[winner] += winning
+			award.Winnings[winner] += winning
 			if remainder > 0 {
 				p.winnings[winner]++
+				award.Winnings[winner]++
 				remainder--
 			}
 		}
+		p.awards = append(p.awards, award)
 	}
 	p.finalized = true
 }
 
+// Awards returns how each subpot was split after the pot has been finalized, main pot first.
+func (p *Pot) Awards() ([]SubpotAward, error) {
+	if !p.finalized {
+		return nil, fmt.Errorf("must finalize the pot before getting awards")
+	}
+	return p.awards, nil
+}
+
 // GetWinnings returns a player's winnings after the pot has been finalized.
 func (p *Pot) GetWinnings(player id.PlayerID) (int64, error) {
 	if !p.finalized {
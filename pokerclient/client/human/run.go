@@ -95,13 +95,22 @@ func main() {
 func decideOnAction(data *ppb.GameData) (*actions.PlayerAction, error) {
 	query := "Select action..."
 
-	action, err := ui.Select(query, []string{
-		ppb.PlayerAction_PlayerActionFold.String(),
-		ppb.PlayerAction_PlayerActionCheck.String(),
-		ppb.PlayerAction_PlayerActionCall.String(),
-		ppb.PlayerAction_PlayerActionAllIn.String(),
-		ppb.PlayerAction_PlayerActionBet.String()}, &input.Options{
-		Default:  ppb.PlayerAction_PlayerActionCheck.String(),
+	// only offer the actions the server allows right now
+	var choices []string
+	for _, a := range data.GetAllowedActions() {
+		choices = append(choices, a.String())
+	}
+
+	def := ppb.PlayerAction_PlayerActionFold
+	switch {
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCheck):
+		def = ppb.PlayerAction_PlayerActionCheck
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCall):
+		def = ppb.PlayerAction_PlayerActionCall
+	}
+
+	action, err := ui.Select(query, choices, &input.Options{
+		Default:  def.String(),
 		Loop:     true,
 		Required: true,
 	})
@@ -131,9 +140,15 @@ func decideOnAction(data *ppb.GameData) (*actions.PlayerAction, error) {
 
 // betAmount asks the user for and returns the amoount to bet
 func betAmount(data *ppb.GameData) (int64, error) {
+	minBet := data.GetMinBet()
+	maxBet := data.GetMaxBet()
+
+	minChoice := fmt.Sprintf("Minimum ($%v)", minBet)
+	maxChoice := fmt.Sprintf("Maximum ($%v)", maxBet)
+
 	query := "Select amount to bet"
-	q, err := ui.Select(query, []string{"Big Blind", "3X Big Blind", "Custom"}, &input.Options{
-		Default:  "3X Big Blind",
+	q, err := ui.Select(query, []string{minChoice, maxChoice, "Custom"}, &input.Options{
+		Default:  minChoice,
 		Loop:     true,
 		Required: true,
 	})
@@ -143,20 +158,20 @@ func betAmount(data *ppb.GameData) (int64, error) {
 	logg.Infof("selected: %v", q)
 
 	switch q {
-	case "Big Blind":
-		return data.GetInfo().GetBigBlind(), nil
-	case "3X Big Blind":
-		return data.GetInfo().GetBigBlind() * 3, nil
+	case minChoice:
+		return minBet, nil
+	case maxChoice:
+		return maxBet, nil
 	default:
 		// fall through
 	}
 
-	query = "How much to bet?"
+	query = fmt.Sprintf("How much to bet? ($%v - $%v)", minBet, maxBet)
 	amountS, err := ui.Ask(query, &input.Options{
-		Default:      "1",
+		Default:      fmt.Sprintf("%d", minBet),
 		Loop:         true,
 		Required:     true,
-		ValidateFunc: validateAmount,
+		ValidateFunc: validateAmount(minBet, maxBet),
 	})
 	if err != nil {
 		return 0, err
@@ -166,10 +181,18 @@ func betAmount(data *ppb.GameData) (int64, error) {
 	return amount, err
 }
 
-// validateAmount is used by ui.Ask. Validates that the amount entered is a valid number.
-func validateAmount(s string) error {
-	_, err := strconv.Atoi(s)
-	return err
+// validateAmount returns a validator for ui.Ask. Validates that the amount entered is a valid number between min and max.
+func validateAmount(min, max int64) func(s string) error {
+	return func(s string) error {
+		amount, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		if amount < min || amount > max {
+			return fmt.Errorf("amount must be between %v and %v", min, max)
+		}
+		return nil
+	}
 }
 
 // SetupCloseHandler creates a 'listener' on a new goroutine which will notify the
@@ -71,6 +71,15 @@ func decideOnAction(data *ppb.GameData) (*actions.PlayerAction, error) {
 
 	paction := ppb.PlayerAction_PlayerActionAllIn
 
+	// the betting structure may not allow going all in right now
+	switch {
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionAllIn):
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCall):
+		paction = ppb.PlayerAction_PlayerActionCall
+	default:
+		paction = ppb.PlayerAction_PlayerActionCheck
+	}
+
 	// First three fields are not used and are set automatically by the client
 	playerAction := actions.NewPlayerAction(id.EmptyPlayerID, id.EmptyTableID, paction, nil, nil)
 
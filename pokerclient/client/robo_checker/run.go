@@ -72,20 +72,14 @@ func decideOnAction(data *ppb.GameData) (*actions.PlayerAction, error) {
 	// This client either calls if required, checks, or goes all in
 	var paction ppb.PlayerAction
 
-	mymoney := data.GetPlayer().GetMoney()
 	switch {
-	case mymoney.BetThisRound < mymoney.MinBetThisRound:
-		switch {
-		case mymoney.Stack > mymoney.MinBetThisRound-mymoney.BetThisRound:
-			// Have enough to call
-			paction = ppb.PlayerAction_PlayerActionCall
-		default:
-			// Must go All In (we never fold!)
-			paction = ppb.PlayerAction_PlayerActionAllIn
-		}
-	default:
-		// Check
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCheck):
 		paction = ppb.PlayerAction_PlayerActionCheck
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCall):
+		// the server caps the call at our stack, so this goes all in if needed (we never fold!)
+		paction = ppb.PlayerAction_PlayerActionCall
+	default:
+		paction = ppb.PlayerAction_PlayerActionAllIn
 	}
 
 	// First three fields are not used and are set automatically by the client
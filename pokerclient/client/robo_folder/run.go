@@ -73,17 +73,11 @@ func decideOnAction(data *ppb.GameData) (*actions.PlayerAction, error) {
 	mymoney := data.GetPlayer().GetMoney()
 
 	switch {
-	case mymoney.BetThisRound < mymoney.MinBetThisRound:
-		switch {
-		case mymoney.Stack > mymoney.MinBetThisRound-mymoney.BetThisRound:
-			// Have enough to call
-			paction = ppb.PlayerAction_PlayerActionCall
-		default:
-			// Fold if can't call
-			paction = ppb.PlayerAction_PlayerActionFold
-		}
+	case roboclient.ActionAllowed(data, ppb.PlayerAction_PlayerActionCall) && mymoney.Stack > data.GetCallAmount():
+		// Have enough to call
+		paction = ppb.PlayerAction_PlayerActionCall
 	default:
-		// Check
+		// Fold if can't call
 		paction = ppb.PlayerAction_PlayerActionFold
 	}
 	// First three fields are not used and are set automatically by the client
@@ -81,6 +81,8 @@ type PokerClient struct {
 	lastAckedToken string
 	lastTurnTaken  int64
 	handFinished   bool
+	// seq of the last table event received
+	lastSeq int64
 
 	gameState ppb.GameState
 	money     *ppb.PlayerMoney
@@ -243,9 +245,21 @@ OUTER:
 			if pc.PlayerID != id.PlayerID(in.PlayerID) {
 				pc.l.Fatal("Mismatch in playerID; expected: %v; got: %v", pc.PlayerID, id.PlayerID(in.PlayerID))
 			}
+			if moved := id.TableID(in.GetMovedFromTableID()); moved != "" && moved == pc.TableID {
+				// tournament table was broken or balanced, the server moved us to another table
+				pc.l.Infof("Moved to table [%v] (%v)", in.GetInfo().GetTableName(), in.GetInfo().GetTableID())
+				pc.TableID = id.TableID(in.GetInfo().GetTableID())
+			}
 			if pc.TableID != id.TableID(in.GetInfo().GetTableID()) {
 				pc.l.Fatalf("Mismatch in tableID; expected: %v; got: %v", pc.TableID, id.TableID(in.GetInfo().GetTableID()))
 			}
+			if pc.missedEvents(in) {
+				pc.l.Infof("missed table events after %v, asking for a snapshot", pc.lastSeq)
+				if err := pc.RequestSnapshot(ctx); err != nil {
+					pc.l.Info(err)
+				}
+			}
+			pc.lastSeq = in.GetSeq()
 			pc.position = in.GetPlayer().GetPosition()
 			pc.money = in.GetPlayer().GetMoney()
 
@@ -261,6 +275,14 @@ OUTER:
 
 			state := in.GetPlayer().GetState()
 			switch {
+			case pc.gameState == ppb.GameState_GameStateTournamentComplete:
+				pc.l.Info(pc.getTournamentResults(in))
+				pc.l.Info("Tournament complete, exiting...")
+				os.Exit(0)
+
+			case in.GetInfo().GetTournament() != nil && pc.HasState(state, ppb.PlayerState_PlayerStateStackEmpty):
+				// tournament chips cannot be bought again, keep watching until the tournament is complete
+
 			case pc.HasState(state, ppb.PlayerState_PlayerStateStackEmpty):
 				if in.GetInfo().GetGameState() <= ppb.GameState_GameStateWaitingPlayers {
 					if err = pc.BuyIn(ctx, in.GetInfo().GetBigBlind()); err != nil {
@@ -296,6 +318,17 @@ OUTER:
 	return err
 }
 
+// missedEvents returns true if table events were lost since the previous update
+func (pc *PokerClient) missedEvents(in *ppb.GameData) bool {
+	if in.GetSnapshot() {
+		return false
+	}
+	if events := in.GetEvents(); len(events) > 0 {
+		return events[0].GetSeq() != pc.lastSeq+1
+	}
+	return in.GetSeq() != pc.lastSeq
+}
+
 func (pc *PokerClient) handIsFinished() bool {
 	return pc.gameState == ppb.GameState_GameStatePlayingDone
 }
@@ -433,6 +466,16 @@ func (pc *PokerClient) Fold(ctx context.Context) error {
 	return nil
 }
 
+// RequestSnapshot asks the server for a fresh snapshot of the table
+func (pc *PokerClient) RequestSnapshot(ctx context.Context) error {
+	req := &ppb.TakeTurnRequest{
+		ClientInfo:   pc.ClientInfo(),
+		PlayerAction: ppb.PlayerAction_PlayerActionSnapshot,
+	}
+	_, err := pc.client.TakeTurn(ctx, req)
+	return err
+}
+
 // Check checks
 func (pc *PokerClient) Check(ctx context.Context) error {
 	pc.l.Info("Action: Check")
@@ -667,15 +710,23 @@ func (pc *PokerClient) getGameState(in *ppb.GameData) string {
 
 	waitName := in.WaitTurnName
 	waitTimeLeft := time.Duration(in.WaitTurnTimeLeftSec * 1000000000)
+	waitTimeBank := time.Duration(in.WaitTurnTimeBankSec * 1000000000)
 
 	var state strings.Builder
 
 	state.WriteString(fmt.Sprintln("================================================================="))
 	state.WriteString(fmt.Sprintf("%v (pos: %v) %v\n", color.GreenString("My Player:"), pc.position, pc.PlayerUsername))
-	state.WriteString(fmt.Sprintf("%v %v (%v)\n", color.GreenString("Turn:"), waitName, waitTimeLeft))
+	state.WriteString(fmt.Sprintf("%v %v (%v, time bank %v)\n", color.GreenString("Turn:"), waitName, waitTimeLeft, waitTimeBank))
 	state.WriteString(fmt.Sprintf("%v %v\n", color.YellowString("Table State:"), in.GetInfo().GetGameState()))
+	state.WriteString(fmt.Sprintf("%v %v (%v)\n", color.YellowString("Table Variant:"), in.GetInfo().GetVariant(), in.GetInfo().GetBettingStructure()))
 	state.WriteString(fmt.Sprintf("%v $%v\n", color.YellowString("Table Buyin:"), humanize.Comma(buyin)))
 
+	if t := in.GetInfo().GetTournament(); t != nil {
+		state.WriteString(fmt.Sprintf("%v level %v, blinds $%v/$%v (ante $%v); next: $%v/$%v (ante $%v)\n", color.YellowString("Tournament:"),
+			t.GetLevel(), in.GetInfo().GetSmallBlind(), in.GetInfo().GetBigBlind(), in.GetInfo().GetAnte(), t.GetNextSmallBlind(), t.GetNextBigBlind(), t.GetNextAnte()))
+		state.WriteString(fmt.Sprintf("%v %v of %v left; prize pool $%v\n", color.YellowString("Players:"), t.GetPlayersLeft(), t.GetEntrants(), humanize.Comma(t.GetPrizePool())))
+	}
+
 	startsIn := time.Duration(time.Second * time.Duration(gameStartsIn*1000000))
 	if startsIn > 0 {
 		state.WriteString(fmt.Sprintf("%v %v\n", color.YellowString("Game Starts In:"), startsIn.Truncate(time.Second)))
@@ -709,6 +760,22 @@ func (pc *PokerClient) getGameState(in *ppb.GameData) string {
 	return state.String()
 }
 
+// getTournamentResults returns the finishing places of the tournament
+func (pc *PokerClient) getTournamentResults(in *ppb.GameData) string {
+	var results strings.Builder
+
+	results.WriteString(fmt.Sprintln(color.GreenString("Tournament Results:")))
+	for _, r := range in.GetInfo().GetTournament().GetResults() {
+		var me string
+		if r.GetPlayerID() == pc.PlayerID.String() {
+			me = color.HiGreenString("(me) ")
+		}
+		results.WriteString(fmt.Sprintf("  %d. %v%v ($%v)\n", r.GetPlace(), me, r.GetName(), humanize.Comma(r.GetPrize())))
+	}
+
+	return results.String()
+}
+
 func (pc *PokerClient) protoToCards(cards []*ppb.Card) []deck.Card {
 	nc := make([]deck.Card, len(cards))
 
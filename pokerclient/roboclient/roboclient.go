@@ -142,6 +142,16 @@ func (r *RoboClient) takeTurn(paction chan *actions.PlayerAction, presult chan *
 	return nil
 }
 
+// ActionAllowed returns true if the server allows the action on this turn
+func ActionAllowed(data *ppb.GameData, a ppb.PlayerAction) bool {
+	for _, allowed := range data.GetAllowedActions() {
+		if allowed == a {
+			return true
+		}
+	}
+	return false
+}
+
 // CommChannels encapsulate the comm channels to pokerClient
 type CommChannels struct {
 	Paction     chan *actions.PlayerAction
@@ -32,18 +32,39 @@ const _ = proto.ProtoPackageIsVersion4
 type PlayerAction int32
 
 const (
-	PlayerAction_PlayerActionNone       PlayerAction = 0
-	PlayerAction_PlayerActionRegister   PlayerAction = 1
-	PlayerAction_PlayerActionJoinTable  PlayerAction = 2
-	PlayerAction_PlayerActionPlay       PlayerAction = 3
-	PlayerAction_PlayerActionCall       PlayerAction = 4
-	PlayerAction_PlayerActionCheck      PlayerAction = 5
-	PlayerAction_PlayerActionBet        PlayerAction = 6
-	PlayerAction_PlayerActionFold       PlayerAction = 7
-	PlayerAction_PlayerActionAckToken   PlayerAction = 8
-	PlayerAction_PlayerActionAllIn      PlayerAction = 9
-	PlayerAction_PlayerActionBuyIn      PlayerAction = 10
-	PlayerAction_PlayerActionDisconnect PlayerAction = 11
+	PlayerAction_PlayerActionNone          PlayerAction = 0
+	PlayerAction_PlayerActionRegister      PlayerAction = 1
+	PlayerAction_PlayerActionJoinTable     PlayerAction = 2
+	PlayerAction_PlayerActionPlay          PlayerAction = 3
+	PlayerAction_PlayerActionCall          PlayerAction = 4
+	PlayerAction_PlayerActionCheck         PlayerAction = 5
+	PlayerAction_PlayerActionBet           PlayerAction = 6
+	PlayerAction_PlayerActionFold          PlayerAction = 7
+	PlayerAction_PlayerActionAckToken      PlayerAction = 8
+	PlayerAction_PlayerActionAllIn         PlayerAction = 9
+	PlayerAction_PlayerActionBuyIn         PlayerAction = 10
+	PlayerAction_PlayerActionDisconnect    PlayerAction = 11
+	PlayerAction_PlayerActionWatch         PlayerAction = 12
+	PlayerAction_PlayerActionListTables    PlayerAction = 13
+	PlayerAction_PlayerActionCreateTable   PlayerAction = 14
+	PlayerAction_PlayerActionUpdateInvites PlayerAction = 15
+	PlayerAction_PlayerActionAutoRebuy     PlayerAction = 16
+	PlayerAction_PlayerActionLeaveTable    PlayerAction = 17
+	PlayerAction_PlayerActionSitOut        PlayerAction = 18
+	PlayerAction_PlayerActionSitIn         PlayerAction = 19
+	PlayerAction_PlayerActionJoinWaitlist  PlayerAction = 20
+	PlayerAction_PlayerActionChangeSeat    PlayerAction = 21
+	// opts in or out of straddling, and the last action of a player that straddled
+	PlayerAction_PlayerActionStraddle PlayerAction = 22
+	// last actions of forced bets, posted by the table
+	PlayerAction_PlayerActionPostAnte       PlayerAction = 23
+	PlayerAction_PlayerActionPostSmallBlind PlayerAction = 24
+	PlayerAction_PlayerActionPostBigBlind   PlayerAction = 25
+	PlayerAction_PlayerActionPostDeadBlind  PlayerAction = 26
+	// queues a pre-action while waiting for the player's turn
+	PlayerAction_PlayerActionPreAction PlayerAction = 27
+	// asks for a fresh snapshot of the table, after missing events
+	PlayerAction_PlayerActionSnapshot PlayerAction = 28
 )
 
 // Enum value maps for PlayerAction.
@@ -61,20 +82,54 @@ var (
 		9:  "PlayerActionAllIn",
 		10: "PlayerActionBuyIn",
 		11: "PlayerActionDisconnect",
+		12: "PlayerActionWatch",
+		13: "PlayerActionListTables",
+		14: "PlayerActionCreateTable",
+		15: "PlayerActionUpdateInvites",
+		16: "PlayerActionAutoRebuy",
+		17: "PlayerActionLeaveTable",
+		18: "PlayerActionSitOut",
+		19: "PlayerActionSitIn",
+		20: "PlayerActionJoinWaitlist",
+		21: "PlayerActionChangeSeat",
+		22: "PlayerActionStraddle",
+		23: "PlayerActionPostAnte",
+		24: "PlayerActionPostSmallBlind",
+		25: "PlayerActionPostBigBlind",
+		26: "PlayerActionPostDeadBlind",
+		27: "PlayerActionPreAction",
+		28: "PlayerActionSnapshot",
 	}
 	PlayerAction_value = map[string]int32{
-		"PlayerActionNone":       0,
-		"PlayerActionRegister":   1,
-		"PlayerActionJoinTable":  2,
-		"PlayerActionPlay":       3,
-		"PlayerActionCall":       4,
-		"PlayerActionCheck":      5,
-		"PlayerActionBet":        6,
-		"PlayerActionFold":       7,
-		"PlayerActionAckToken":   8,
-		"PlayerActionAllIn":      9,
-		"PlayerActionBuyIn":      10,
-		"PlayerActionDisconnect": 11,
+		"PlayerActionNone":           0,
+		"PlayerActionRegister":       1,
+		"PlayerActionJoinTable":      2,
+		"PlayerActionPlay":           3,
+		"PlayerActionCall":           4,
+		"PlayerActionCheck":          5,
+		"PlayerActionBet":            6,
+		"PlayerActionFold":           7,
+		"PlayerActionAckToken":       8,
+		"PlayerActionAllIn":          9,
+		"PlayerActionBuyIn":          10,
+		"PlayerActionDisconnect":     11,
+		"PlayerActionWatch":          12,
+		"PlayerActionListTables":     13,
+		"PlayerActionCreateTable":    14,
+		"PlayerActionUpdateInvites":  15,
+		"PlayerActionAutoRebuy":      16,
+		"PlayerActionLeaveTable":     17,
+		"PlayerActionSitOut":         18,
+		"PlayerActionSitIn":          19,
+		"PlayerActionJoinWaitlist":   20,
+		"PlayerActionChangeSeat":     21,
+		"PlayerActionStraddle":       22,
+		"PlayerActionPostAnte":       23,
+		"PlayerActionPostSmallBlind": 24,
+		"PlayerActionPostBigBlind":   25,
+		"PlayerActionPostDeadBlind":  26,
+		"PlayerActionPreAction":      27,
+		"PlayerActionSnapshot":       28,
 	}
 )
 
@@ -105,6 +160,69 @@ func (PlayerAction) EnumDescriptor() ([]byte, []int) {
 	return file_poker_proto_rawDescGZIP(), []int{0}
 }
 
+// PreAction is an action queued before the player's turn, applied as soon as the turn arrives
+type PreAction int32
+
+const (
+	PreAction_PreActionNone PreAction = 0
+	// checks if checking is free, folds otherwise
+	PreAction_PreActionCheckFold PreAction = 1
+	// checks, cancelled if someone bets
+	PreAction_PreActionCheck PreAction = 2
+	// calls the current bet, cancelled if the bet changes
+	PreAction_PreActionCall PreAction = 3
+	// calls any bet, checks if checking is free
+	PreAction_PreActionCallAny PreAction = 4
+	PreAction_PreActionFold    PreAction = 5
+)
+
+// Enum value maps for PreAction.
+var (
+	PreAction_name = map[int32]string{
+		0: "PreActionNone",
+		1: "PreActionCheckFold",
+		2: "PreActionCheck",
+		3: "PreActionCall",
+		4: "PreActionCallAny",
+		5: "PreActionFold",
+	}
+	PreAction_value = map[string]int32{
+		"PreActionNone":      0,
+		"PreActionCheckFold": 1,
+		"PreActionCheck":     2,
+		"PreActionCall":      3,
+		"PreActionCallAny":   4,
+		"PreActionFold":      5,
+	}
+)
+
+func (x PreAction) Enum() *PreAction {
+	p := new(PreAction)
+	*p = x
+	return p
+}
+
+func (x PreAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_poker_proto_enumTypes[1].Descriptor()
+}
+
+func (PreAction) Type() protoreflect.EnumType {
+	return &file_poker_proto_enumTypes[1]
+}
+
+func (x PreAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreAction.Descriptor instead.
+func (PreAction) EnumDescriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{1}
+}
+
 type GameState int32
 
 const (
@@ -119,6 +237,9 @@ const (
 	GameState_GameStatePlayingRiver      GameState = 8
 	GameState_GameStatePlayingDone       GameState = 9
 	GameState_GameStateFinished          GameState = 10
+	// GameStateTournamentComplete is the terminal state of a tournament table,
+	// no more hands are played
+	GameState_GameStateTournamentComplete GameState = 11
 )
 
 // Enum value maps for GameState.
@@ -135,19 +256,21 @@ var (
 		8:  "GameStatePlayingRiver",
 		9:  "GameStatePlayingDone",
 		10: "GameStateFinished",
+		11: "GameStateTournamentComplete",
 	}
 	GameState_value = map[string]int32{
-		"GameStateWaitingPlayers":    0,
-		"GameStateInitializing":      1,
-		"GameStateReadyToStart":      2,
-		"GameStatePlayingSmallBlind": 3,
-		"GameStatePlayingBigBlind":   4,
-		"GameStatePlayingPreFlop":    5,
-		"GameStatePlayingFlop":       6,
-		"GameStatePlayingTurn":       7,
-		"GameStatePlayingRiver":      8,
-		"GameStatePlayingDone":       9,
-		"GameStateFinished":          10,
+		"GameStateWaitingPlayers":     0,
+		"GameStateInitializing":       1,
+		"GameStateReadyToStart":       2,
+		"GameStatePlayingSmallBlind":  3,
+		"GameStatePlayingBigBlind":    4,
+		"GameStatePlayingPreFlop":     5,
+		"GameStatePlayingFlop":        6,
+		"GameStatePlayingTurn":        7,
+		"GameStatePlayingRiver":       8,
+		"GameStatePlayingDone":        9,
+		"GameStateFinished":           10,
+		"GameStateTournamentComplete": 11,
 	}
 )
 
@@ -162,11 +285,11 @@ func (x GameState) String() string {
 }
 
 func (GameState) Descriptor() protoreflect.EnumDescriptor {
-	return file_poker_proto_enumTypes[1].Descriptor()
+	return file_poker_proto_enumTypes[2].Descriptor()
 }
 
 func (GameState) Type() protoreflect.EnumType {
-	return &file_poker_proto_enumTypes[1]
+	return &file_poker_proto_enumTypes[2]
 }
 
 func (x GameState) Number() protoreflect.EnumNumber {
@@ -175,7 +298,175 @@ func (x GameState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameState.Descriptor instead.
 func (GameState) EnumDescriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{1}
+	return file_poker_proto_rawDescGZIP(), []int{2}
+}
+
+// GameVariant is the poker variant played at a table
+type GameVariant int32
+
+const (
+	// GameVariantTexasHoldem is no-limit Texas Hold'em, two hole cards
+	GameVariant_GameVariantTexasHoldem GameVariant = 0
+	// GameVariantPotLimitOmaha is pot-limit Omaha, four hole cards of which
+	// exactly two must be used together with exactly three board cards
+	GameVariant_GameVariantPotLimitOmaha GameVariant = 1
+)
+
+// Enum value maps for GameVariant.
+var (
+	GameVariant_name = map[int32]string{
+		0: "GameVariantTexasHoldem",
+		1: "GameVariantPotLimitOmaha",
+	}
+	GameVariant_value = map[string]int32{
+		"GameVariantTexasHoldem":   0,
+		"GameVariantPotLimitOmaha": 1,
+	}
+)
+
+func (x GameVariant) Enum() *GameVariant {
+	p := new(GameVariant)
+	*p = x
+	return p
+}
+
+func (x GameVariant) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameVariant) Descriptor() protoreflect.EnumDescriptor {
+	return file_poker_proto_enumTypes[3].Descriptor()
+}
+
+func (GameVariant) Type() protoreflect.EnumType {
+	return &file_poker_proto_enumTypes[3]
+}
+
+func (x GameVariant) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameVariant.Descriptor instead.
+func (GameVariant) EnumDescriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{3}
+}
+
+// BettingStructure determines the legal bet and raise sizes at a table
+type BettingStructure int32
+
+const (
+	// BettingStructureNoLimit allows any bet up to the player's stack
+	BettingStructure_BettingStructureNoLimit BettingStructure = 0
+	// BettingStructurePotLimit caps every bet and raise at the size of the pot
+	BettingStructure_BettingStructurePotLimit BettingStructure = 1
+	// BettingStructureFixedLimit uses fixed small and big bet sizes with a
+	// capped number of raises per betting round
+	BettingStructure_BettingStructureFixedLimit BettingStructure = 2
+)
+
+// Enum value maps for BettingStructure.
+var (
+	BettingStructure_name = map[int32]string{
+		0: "BettingStructureNoLimit",
+		1: "BettingStructurePotLimit",
+		2: "BettingStructureFixedLimit",
+	}
+	BettingStructure_value = map[string]int32{
+		"BettingStructureNoLimit":    0,
+		"BettingStructurePotLimit":   1,
+		"BettingStructureFixedLimit": 2,
+	}
+)
+
+func (x BettingStructure) Enum() *BettingStructure {
+	p := new(BettingStructure)
+	*p = x
+	return p
+}
+
+func (x BettingStructure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BettingStructure) Descriptor() protoreflect.EnumDescriptor {
+	return file_poker_proto_enumTypes[4].Descriptor()
+}
+
+func (BettingStructure) Type() protoreflect.EnumType {
+	return &file_poker_proto_enumTypes[4]
+}
+
+func (x BettingStructure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BettingStructure.Descriptor instead.
+func (BettingStructure) EnumDescriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{4}
+}
+
+type TableEventType int32
+
+const (
+	TableEventType_TableEventNone TableEventType = 0
+	// a player acted, or posted a blind or an ante
+	TableEventType_TableEventActionTaken TableEventType = 1
+	// hole cards dealt to a player, or cards dealt to the board
+	TableEventType_TableEventCardDealt    TableEventType = 2
+	TableEventType_TableEventStateChanged TableEventType = 3
+	TableEventType_TableEventTurnStarted  TableEventType = 4
+	TableEventType_TableEventPotAwarded   TableEventType = 5
+	// a player showed their hole cards at showdown
+	TableEventType_TableEventCardsShown TableEventType = 6
+)
+
+// Enum value maps for TableEventType.
+var (
+	TableEventType_name = map[int32]string{
+		0: "TableEventNone",
+		1: "TableEventActionTaken",
+		2: "TableEventCardDealt",
+		3: "TableEventStateChanged",
+		4: "TableEventTurnStarted",
+		5: "TableEventPotAwarded",
+		6: "TableEventCardsShown",
+	}
+	TableEventType_value = map[string]int32{
+		"TableEventNone":         0,
+		"TableEventActionTaken":  1,
+		"TableEventCardDealt":    2,
+		"TableEventStateChanged": 3,
+		"TableEventTurnStarted":  4,
+		"TableEventPotAwarded":   5,
+		"TableEventCardsShown":   6,
+	}
+)
+
+func (x TableEventType) Enum() *TableEventType {
+	p := new(TableEventType)
+	*p = x
+	return p
+}
+
+func (x TableEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TableEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_poker_proto_enumTypes[5].Descriptor()
+}
+
+func (TableEventType) Type() protoreflect.EnumType {
+	return &file_poker_proto_enumTypes[5]
+}
+
+func (x TableEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TableEventType.Descriptor instead.
+func (TableEventType) EnumDescriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{5}
 }
 
 // PlayerState is the player state according to the server
@@ -191,6 +482,12 @@ const (
 	PlayerState_PlayerStateBankEmpty PlayerState = 3
 	// PlayerStateCurrentTurn marks this player's turn
 	PlayerState_PlayerStateCurrentTurn PlayerState = 4
+	// PlayerStateSittingOut is a player keeping the seat without being dealt in
+	PlayerState_PlayerStateSittingOut PlayerState = 5
+	// PlayerStateLeaving is a player leaving the table once the current hand is done
+	PlayerState_PlayerStateLeaving PlayerState = 6
+	// PlayerStateDisconnected is a player whose seat is held until they reconnect
+	PlayerState_PlayerStateDisconnected PlayerState = 7
 )
 
 // Enum value maps for PlayerState.
@@ -201,13 +498,19 @@ var (
 		2: "PlayerStateStackEmpty",
 		3: "PlayerStateBankEmpty",
 		4: "PlayerStateCurrentTurn",
+		5: "PlayerStateSittingOut",
+		6: "PlayerStateLeaving",
+		7: "PlayerStateDisconnected",
 	}
 	PlayerState_value = map[string]int32{
-		"PlayerStateDefault":     0,
-		"PlayerStateFolded":      1,
-		"PlayerStateStackEmpty":  2,
-		"PlayerStateBankEmpty":   3,
-		"PlayerStateCurrentTurn": 4,
+		"PlayerStateDefault":      0,
+		"PlayerStateFolded":       1,
+		"PlayerStateStackEmpty":   2,
+		"PlayerStateBankEmpty":    3,
+		"PlayerStateCurrentTurn":  4,
+		"PlayerStateSittingOut":   5,
+		"PlayerStateLeaving":      6,
+		"PlayerStateDisconnected": 7,
 	}
 )
 
@@ -222,11 +525,11 @@ func (x PlayerState) String() string {
 }
 
 func (PlayerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_poker_proto_enumTypes[2].Descriptor()
+	return file_poker_proto_enumTypes[6].Descriptor()
 }
 
 func (PlayerState) Type() protoreflect.EnumType {
-	return &file_poker_proto_enumTypes[2]
+	return &file_poker_proto_enumTypes[6]
 }
 
 func (x PlayerState) Number() protoreflect.EnumNumber {
@@ -235,7 +538,7 @@ func (x PlayerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayerState.Descriptor instead.
 func (PlayerState) EnumDescriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{2}
+	return file_poker_proto_rawDescGZIP(), []int{6}
 }
 
 type CardSuit int32
@@ -274,11 +577,11 @@ func (x CardSuit) String() string {
 }
 
 func (CardSuit) Descriptor() protoreflect.EnumDescriptor {
-	return file_poker_proto_enumTypes[3].Descriptor()
+	return file_poker_proto_enumTypes[7].Descriptor()
 }
 
 func (CardSuit) Type() protoreflect.EnumType {
-	return &file_poker_proto_enumTypes[3]
+	return &file_poker_proto_enumTypes[7]
 }
 
 func (x CardSuit) Number() protoreflect.EnumNumber {
@@ -287,7 +590,7 @@ func (x CardSuit) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CardSuit.Descriptor instead.
 func (CardSuit) EnumDescriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{3}
+	return file_poker_proto_rawDescGZIP(), []int{7}
 }
 
 type CardRank int32
@@ -353,11 +656,11 @@ func (x CardRank) String() string {
 }
 
 func (CardRank) Descriptor() protoreflect.EnumDescriptor {
-	return file_poker_proto_enumTypes[4].Descriptor()
+	return file_poker_proto_enumTypes[8].Descriptor()
 }
 
 func (CardRank) Type() protoreflect.EnumType {
-	return &file_poker_proto_enumTypes[4]
+	return &file_poker_proto_enumTypes[8]
 }
 
 func (x CardRank) Number() protoreflect.EnumNumber {
@@ -366,7 +669,7 @@ func (x CardRank) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CardRank.Descriptor instead.
 func (CardRank) EnumDescriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{4}
+	return file_poker_proto_rawDescGZIP(), []int{8}
 }
 
 type AckTokenRequest struct {
@@ -471,6 +774,19 @@ type ActionOpts struct {
 	BetAmount int64 `protobuf:"varint,10,opt,name=betAmount,proto3" json:"betAmount,omitempty"`
 	// Ack options
 	AckToken string `protobuf:"bytes,20,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
+	// Join options, the password of a private table
+	TablePassword string `protobuf:"bytes,30,opt,name=tablePassword,proto3" json:"tablePassword,omitempty"`
+	// BuyIn options, 0 buys in for the table maximum
+	BuyinAmount int64      `protobuf:"varint,40,opt,name=buyinAmount,proto3" json:"buyinAmount,omitempty"`
+	AutoRebuy   *AutoRebuy `protobuf:"bytes,50,opt,name=autoRebuy,proto3" json:"autoRebuy,omitempty"`
+	// SitOut options, the number of hands to sit out, 0 sits out until the player sits back in
+	SitOutHands int64 `protobuf:"varint,60,opt,name=sitOutHands,proto3" json:"sitOutHands,omitempty"`
+	// Join and ChangeSeat options, seats are numbered from 1; 0 picks any open seat
+	Seat int64 `protobuf:"varint,70,opt,name=seat,proto3" json:"seat,omitempty"`
+	// Straddle options, true straddles every hand the player is under the gun
+	Straddle bool `protobuf:"varint,80,opt,name=straddle,proto3" json:"straddle,omitempty"`
+	// PreAction options, PreActionNone cancels the queued pre-action
+	PreAction PreAction `protobuf:"varint,90,opt,name=preAction,proto3,enum=poker.PreAction" json:"preAction,omitempty"`
 }
 
 func (x *ActionOpts) Reset() {
@@ -519,6 +835,112 @@ func (x *ActionOpts) GetAckToken() string {
 	return ""
 }
 
+func (x *ActionOpts) GetTablePassword() string {
+	if x != nil {
+		return x.TablePassword
+	}
+	return ""
+}
+
+func (x *ActionOpts) GetBuyinAmount() int64 {
+	if x != nil {
+		return x.BuyinAmount
+	}
+	return 0
+}
+
+func (x *ActionOpts) GetAutoRebuy() *AutoRebuy {
+	if x != nil {
+		return x.AutoRebuy
+	}
+	return nil
+}
+
+func (x *ActionOpts) GetSitOutHands() int64 {
+	if x != nil {
+		return x.SitOutHands
+	}
+	return 0
+}
+
+func (x *ActionOpts) GetSeat() int64 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ActionOpts) GetStraddle() bool {
+	if x != nil {
+		return x.Straddle
+	}
+	return false
+}
+
+func (x *ActionOpts) GetPreAction() PreAction {
+	if x != nil {
+		return x.PreAction
+	}
+	return PreAction_PreActionNone
+}
+
+// AutoRebuy refills the stack to the given level between hands, whenever it drops below the threshold
+// A zero "to" turns auto-rebuy off.
+type AutoRebuy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Below int64 `protobuf:"varint,10,opt,name=below,proto3" json:"below,omitempty"`
+	To    int64 `protobuf:"varint,20,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *AutoRebuy) Reset() {
+	*x = AutoRebuy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoRebuy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRebuy) ProtoMessage() {}
+
+func (x *AutoRebuy) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoRebuy.ProtoReflect.Descriptor instead.
+func (*AutoRebuy) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AutoRebuy) GetBelow() int64 {
+	if x != nil {
+		return x.Below
+	}
+	return 0
+}
+
+func (x *AutoRebuy) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -531,7 +953,7 @@ type RegisterRequest struct {
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[3]
+		mi := &file_poker_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -544,7 +966,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[3]
+	mi := &file_poker_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +979,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{3}
+	return file_poker_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RegisterRequest) GetClientInfo() *ClientInfo {
@@ -586,7 +1008,7 @@ type RegisterResponse struct {
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[4]
+		mi := &file_poker_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -599,7 +1021,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[4]
+	mi := &file_poker_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +1034,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{4}
+	return file_poker_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegisterResponse) GetPlayerID() string {
@@ -637,12 +1059,19 @@ type JoinTableRequest struct {
 	ClientInfo   *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
 	PlayerAction PlayerAction `protobuf:"varint,20,opt,name=playerAction,proto3,enum=poker.PlayerAction" json:"playerAction,omitempty"`
 	TableID      string       `protobuf:"bytes,30,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	// password of a private table, not needed if invited
+	Password string `protobuf:"bytes,40,opt,name=password,proto3" json:"password,omitempty"`
+	// buyin amount, 0 buys in for the table maximum
+	BuyinAmount int64      `protobuf:"varint,50,opt,name=buyinAmount,proto3" json:"buyinAmount,omitempty"`
+	AutoRebuy   *AutoRebuy `protobuf:"bytes,60,opt,name=autoRebuy,proto3" json:"autoRebuy,omitempty"`
+	// preferred seat, numbered from 1; 0 picks any open seat
+	Seat int64 `protobuf:"varint,70,opt,name=seat,proto3" json:"seat,omitempty"`
 }
 
 func (x *JoinTableRequest) Reset() {
 	*x = JoinTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[5]
+		mi := &file_poker_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +1084,7 @@ func (x *JoinTableRequest) String() string {
 func (*JoinTableRequest) ProtoMessage() {}
 
 func (x *JoinTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[5]
+	mi := &file_poker_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +1097,7 @@ func (x *JoinTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinTableRequest.ProtoReflect.Descriptor instead.
 func (*JoinTableRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{5}
+	return file_poker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *JoinTableRequest) GetClientInfo() *ClientInfo {
@@ -692,6 +1121,34 @@ func (x *JoinTableRequest) GetTableID() string {
 	return ""
 }
 
+func (x *JoinTableRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinTableRequest) GetBuyinAmount() int64 {
+	if x != nil {
+		return x.BuyinAmount
+	}
+	return 0
+}
+
+func (x *JoinTableRequest) GetAutoRebuy() *AutoRebuy {
+	if x != nil {
+		return x.AutoRebuy
+	}
+	return nil
+}
+
+func (x *JoinTableRequest) GetSeat() int64 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
 type JoinTableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -705,7 +1162,7 @@ type JoinTableResponse struct {
 func (x *JoinTableResponse) Reset() {
 	*x = JoinTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[6]
+		mi := &file_poker_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -718,7 +1175,7 @@ func (x *JoinTableResponse) String() string {
 func (*JoinTableResponse) ProtoMessage() {}
 
 func (x *JoinTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[6]
+	mi := &file_poker_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1188,7 @@ func (x *JoinTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinTableResponse.ProtoReflect.Descriptor instead.
 func (*JoinTableResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{6}
+	return file_poker_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *JoinTableResponse) GetTableID() string {
@@ -768,7 +1225,7 @@ type TakeTurnRequest struct {
 func (x *TakeTurnRequest) Reset() {
 	*x = TakeTurnRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[7]
+		mi := &file_poker_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -781,7 +1238,7 @@ func (x *TakeTurnRequest) String() string {
 func (*TakeTurnRequest) ProtoMessage() {}
 
 func (x *TakeTurnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[7]
+	mi := &file_poker_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,7 +1251,7 @@ func (x *TakeTurnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TakeTurnRequest.ProtoReflect.Descriptor instead.
 func (*TakeTurnRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{7}
+	return file_poker_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TakeTurnRequest) GetClientInfo() *ClientInfo {
@@ -829,7 +1286,7 @@ type TakeTurnResponse struct {
 func (x *TakeTurnResponse) Reset() {
 	*x = TakeTurnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[8]
+		mi := &file_poker_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +1299,7 @@ func (x *TakeTurnResponse) String() string {
 func (*TakeTurnResponse) ProtoMessage() {}
 
 func (x *TakeTurnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[8]
+	mi := &file_poker_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1312,7 @@ func (x *TakeTurnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TakeTurnResponse.ProtoReflect.Descriptor instead.
 func (*TakeTurnResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{8}
+	return file_poker_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TakeTurnResponse) GetMessage() string {
@@ -876,7 +1333,7 @@ type DisconnectResponse struct {
 func (x *DisconnectResponse) Reset() {
 	*x = DisconnectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[9]
+		mi := &file_poker_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +1346,7 @@ func (x *DisconnectResponse) String() string {
 func (*DisconnectResponse) ProtoMessage() {}
 
 func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[9]
+	mi := &file_poker_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1359,7 @@ func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
 func (*DisconnectResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{9}
+	return file_poker_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DisconnectResponse) GetMessage() string {
@@ -912,33 +1369,61 @@ func (x *DisconnectResponse) GetMessage() string {
 	return ""
 }
 
-// PlayRequest is sent to register for the GameData streaming response
-type PlayRequest struct {
+// TableConfig configures a new cash table, unset fields use the server defaults
+type TableConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientInfo   *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
-	PlayerAction PlayerAction `protobuf:"varint,50,opt,name=playerAction,proto3,enum=poker.PlayerAction" json:"playerAction,omitempty"`
-}
-
-func (x *PlayRequest) Reset() {
-	*x = PlayRequest{}
+	Name string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	// the server defaults are used when unset; the structure defaults to the
+	// one the variant is usually played with when only the variant is set
+	Variant          *GameVariant      `protobuf:"varint,20,opt,name=variant,proto3,enum=poker.GameVariant,oneof" json:"variant,omitempty"`
+	BettingStructure *BettingStructure `protobuf:"varint,30,opt,name=bettingStructure,proto3,enum=poker.BettingStructure,oneof" json:"bettingStructure,omitempty"`
+	SmallBlind       int64             `protobuf:"varint,40,opt,name=smallBlind,proto3" json:"smallBlind,omitempty"`
+	BigBlind         int64             `protobuf:"varint,50,opt,name=bigBlind,proto3" json:"bigBlind,omitempty"`
+	MinPlayers       int64             `protobuf:"varint,60,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
+	MaxPlayers       int64             `protobuf:"varint,70,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	// buyin range in big blinds
+	MinBuyinBigBlinds  int64 `protobuf:"varint,80,opt,name=minBuyinBigBlinds,proto3" json:"minBuyinBigBlinds,omitempty"`
+	MaxBuyinBigBlinds  int64 `protobuf:"varint,90,opt,name=maxBuyinBigBlinds,proto3" json:"maxBuyinBigBlinds,omitempty"`
+	PlayerTimeoutSec   int64 `protobuf:"varint,100,opt,name=playerTimeoutSec,proto3" json:"playerTimeoutSec,omitempty"`
+	GameWaitTimeoutSec int64 `protobuf:"varint,110,opt,name=gameWaitTimeoutSec,proto3" json:"gameWaitTimeoutSec,omitempty"`
+	// private tables are not listed and players are never seated there
+	// automatically; players join with the password or an invite
+	Private  bool     `protobuf:"varint,120,opt,name=private,proto3" json:"private,omitempty"`
+	Password string   `protobuf:"bytes,130,opt,name=password,proto3" json:"password,omitempty"`
+	Invited  []string `protobuf:"bytes,140,rep,name=invited,proto3" json:"invited,omitempty"`
+	// ante posted by every player, or by the big blind alone for every player dealt in
+	Ante         int64 `protobuf:"varint,150,opt,name=ante,proto3" json:"ante,omitempty"`
+	BigBlindAnte bool  `protobuf:"varint,160,opt,name=bigBlindAnte,proto3" json:"bigBlindAnte,omitempty"`
+	// allows the player under the gun to straddle
+	Straddle bool `protobuf:"varint,170,opt,name=straddle,proto3" json:"straddle,omitempty"`
+	// extra time to act once the turn timer runs out, refilled every few hands
+	TimeBankSec         int64 `protobuf:"varint,180,opt,name=timeBankSec,proto3" json:"timeBankSec,omitempty"`
+	TimeBankRefillSec   int64 `protobuf:"varint,190,opt,name=timeBankRefillSec,proto3" json:"timeBankRefillSec,omitempty"`
+	TimeBankRefillHands int64 `protobuf:"varint,200,opt,name=timeBankRefillHands,proto3" json:"timeBankRefillHands,omitempty"`
+	// how long the seat of a disconnected player is held for them to reconnect
+	DisconnectGraceSec int64 `protobuf:"varint,210,opt,name=disconnectGraceSec,proto3" json:"disconnectGraceSec,omitempty"`
+}
+
+func (x *TableConfig) Reset() {
+	*x = TableConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[10]
+		mi := &file_poker_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlayRequest) String() string {
+func (x *TableConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayRequest) ProtoMessage() {}
+func (*TableConfig) ProtoMessage() {}
 
-func (x *PlayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[10]
+func (x *TableConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,285 +1434,241 @@ func (x *PlayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayRequest.ProtoReflect.Descriptor instead.
-func (*PlayRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TableConfig.ProtoReflect.Descriptor instead.
+func (*TableConfig) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PlayRequest) GetClientInfo() *ClientInfo {
+func (x *TableConfig) GetName() string {
 	if x != nil {
-		return x.ClientInfo
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PlayRequest) GetPlayerAction() PlayerAction {
-	if x != nil {
-		return x.PlayerAction
+func (x *TableConfig) GetVariant() GameVariant {
+	if x != nil && x.Variant != nil {
+		return *x.Variant
 	}
-	return PlayerAction_PlayerActionNone
-}
-
-// ClientInfo is sent to the server with every RPC request, contains common data
-// Not all fields are filled in for all requests
-type ClientInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PlayerID       string `protobuf:"bytes,10,opt,name=playerID,proto3" json:"playerID,omitempty"`
-	PlayerName     string `protobuf:"bytes,20,opt,name=playerName,proto3" json:"playerName,omitempty"`
-	PlayerUsername string `protobuf:"bytes,30,opt,name=playerUsername,proto3" json:"playerUsername,omitempty"`
-	TableID        string `protobuf:"bytes,40,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	return GameVariant_GameVariantTexasHoldem
 }
 
-func (x *ClientInfo) Reset() {
-	*x = ClientInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TableConfig) GetBettingStructure() BettingStructure {
+	if x != nil && x.BettingStructure != nil {
+		return *x.BettingStructure
 	}
+	return BettingStructure_BettingStructureNoLimit
 }
 
-func (x *ClientInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ClientInfo) ProtoMessage() {}
-
-func (x *ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TableConfig) GetSmallBlind() int64 {
+	if x != nil {
+		return x.SmallBlind
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
-func (*ClientInfo) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{11}
+	return 0
 }
 
-func (x *ClientInfo) GetPlayerID() string {
+func (x *TableConfig) GetBigBlind() int64 {
 	if x != nil {
-		return x.PlayerID
+		return x.BigBlind
 	}
-	return ""
+	return 0
 }
 
-func (x *ClientInfo) GetPlayerName() string {
+func (x *TableConfig) GetMinPlayers() int64 {
 	if x != nil {
-		return x.PlayerName
+		return x.MinPlayers
 	}
-	return ""
+	return 0
 }
 
-func (x *ClientInfo) GetPlayerUsername() string {
+func (x *TableConfig) GetMaxPlayers() int64 {
 	if x != nil {
-		return x.PlayerUsername
+		return x.MaxPlayers
 	}
-	return ""
+	return 0
 }
 
-func (x *ClientInfo) GetTableID() string {
+func (x *TableConfig) GetMinBuyinBigBlinds() int64 {
 	if x != nil {
-		return x.TableID
+		return x.MinBuyinBigBlinds
 	}
-	return ""
-}
-
-// GameInfo contains all the game info
-type GameInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TableName          string          `protobuf:"bytes,10,opt,name=tableName,proto3" json:"tableName,omitempty"`
-	TableID            string          `protobuf:"bytes,20,opt,name=tableID,proto3" json:"tableID,omitempty"`
-	GameState          GameState       `protobuf:"varint,30,opt,name=gameState,proto3,enum=poker.GameState" json:"gameState,omitempty"`
-	GameStartsInSec    int64           `protobuf:"varint,40,opt,name=gameStartsInSec,proto3" json:"gameStartsInSec,omitempty"`
-	GameStartsInMaxSec int64           `protobuf:"varint,45,opt,name=gameStartsInMaxSec,proto3" json:"gameStartsInMaxSec,omitempty"`
-	AckToken           string          `protobuf:"bytes,50,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
-	CommunityCards     *CommunityCards `protobuf:"bytes,60,opt,name=CommunityCards,proto3" json:"CommunityCards,omitempty"`
-	MaxPlayers         int64           `protobuf:"varint,100,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
-	MinPlayers         int64           `protobuf:"varint,110,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
-	BigBlind           int64           `protobuf:"varint,120,opt,name=bigBlind,proto3" json:"bigBlind,omitempty"`
-	SmallBlind         int64           `protobuf:"varint,125,opt,name=smallBlind,proto3" json:"smallBlind,omitempty"`
-	Buyin              int64           `protobuf:"varint,130,opt,name=buyin,proto3" json:"buyin,omitempty"`
-	ButtonPosition     int64           `protobuf:"varint,140,opt,name=buttonPosition,proto3" json:"buttonPosition,omitempty"`
-	SmallBlindPosition int64           `protobuf:"varint,150,opt,name=smallBlindPosition,proto3" json:"smallBlindPosition,omitempty"`
-	BigBlindPosition   int64           `protobuf:"varint,160,opt,name=bigBlindPosition,proto3" json:"bigBlindPosition,omitempty"`
-	// All players, no confidential info
-	Players    []*Player  `protobuf:"bytes,170,rep,name=players,proto3" json:"players,omitempty"`
-	WinningIds []*Winners `protobuf:"bytes,180,rep,name=winning_ids,json=winningIds,proto3" json:"winning_ids,omitempty"`
+	return 0
 }
 
-func (x *GameInfo) Reset() {
-	*x = GameInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TableConfig) GetMaxBuyinBigBlinds() int64 {
+	if x != nil {
+		return x.MaxBuyinBigBlinds
 	}
+	return 0
 }
 
-func (x *GameInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameInfo) ProtoMessage() {}
-
-func (x *GameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TableConfig) GetPlayerTimeoutSec() int64 {
+	if x != nil {
+		return x.PlayerTimeoutSec
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
-func (*GameInfo) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{12}
+	return 0
 }
 
-func (x *GameInfo) GetTableName() string {
+func (x *TableConfig) GetGameWaitTimeoutSec() int64 {
 	if x != nil {
-		return x.TableName
+		return x.GameWaitTimeoutSec
 	}
-	return ""
+	return 0
 }
 
-func (x *GameInfo) GetTableID() string {
+func (x *TableConfig) GetPrivate() bool {
 	if x != nil {
-		return x.TableID
+		return x.Private
 	}
-	return ""
+	return false
 }
 
-func (x *GameInfo) GetGameState() GameState {
+func (x *TableConfig) GetPassword() string {
 	if x != nil {
-		return x.GameState
+		return x.Password
 	}
-	return GameState_GameStateWaitingPlayers
+	return ""
 }
 
-func (x *GameInfo) GetGameStartsInSec() int64 {
+func (x *TableConfig) GetInvited() []string {
 	if x != nil {
-		return x.GameStartsInSec
+		return x.Invited
 	}
-	return 0
+	return nil
 }
 
-func (x *GameInfo) GetGameStartsInMaxSec() int64 {
+func (x *TableConfig) GetAnte() int64 {
 	if x != nil {
-		return x.GameStartsInMaxSec
+		return x.Ante
 	}
 	return 0
 }
 
-func (x *GameInfo) GetAckToken() string {
+func (x *TableConfig) GetBigBlindAnte() bool {
 	if x != nil {
-		return x.AckToken
+		return x.BigBlindAnte
 	}
-	return ""
+	return false
 }
 
-func (x *GameInfo) GetCommunityCards() *CommunityCards {
+func (x *TableConfig) GetStraddle() bool {
 	if x != nil {
-		return x.CommunityCards
+		return x.Straddle
 	}
-	return nil
+	return false
 }
 
-func (x *GameInfo) GetMaxPlayers() int64 {
+func (x *TableConfig) GetTimeBankSec() int64 {
 	if x != nil {
-		return x.MaxPlayers
+		return x.TimeBankSec
 	}
 	return 0
 }
 
-func (x *GameInfo) GetMinPlayers() int64 {
+func (x *TableConfig) GetTimeBankRefillSec() int64 {
 	if x != nil {
-		return x.MinPlayers
+		return x.TimeBankRefillSec
 	}
 	return 0
 }
 
-func (x *GameInfo) GetBigBlind() int64 {
+func (x *TableConfig) GetTimeBankRefillHands() int64 {
 	if x != nil {
-		return x.BigBlind
+		return x.TimeBankRefillHands
 	}
 	return 0
 }
 
-func (x *GameInfo) GetSmallBlind() int64 {
+func (x *TableConfig) GetDisconnectGraceSec() int64 {
 	if x != nil {
-		return x.SmallBlind
+		return x.DisconnectGraceSec
 	}
 	return 0
 }
 
-func (x *GameInfo) GetBuyin() int64 {
-	if x != nil {
-		return x.Buyin
+type UpdateInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo *ClientInfo `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	TableID    string      `protobuf:"bytes,20,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	// usernames to invite and to revoke
+	Invite []string `protobuf:"bytes,30,rep,name=invite,proto3" json:"invite,omitempty"`
+	Revoke []string `protobuf:"bytes,40,rep,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (x *UpdateInvitesRequest) Reset() {
+	*x = UpdateInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GameInfo) GetButtonPosition() int64 {
-	if x != nil {
-		return x.ButtonPosition
+func (x *UpdateInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInvitesRequest) ProtoMessage() {}
+
+func (x *UpdateInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameInfo) GetSmallBlindPosition() int64 {
+// Deprecated: Use UpdateInvitesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateInvitesRequest) GetClientInfo() *ClientInfo {
 	if x != nil {
-		return x.SmallBlindPosition
+		return x.ClientInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *GameInfo) GetBigBlindPosition() int64 {
+func (x *UpdateInvitesRequest) GetTableID() string {
 	if x != nil {
-		return x.BigBlindPosition
+		return x.TableID
 	}
-	return 0
+	return ""
 }
 
-func (x *GameInfo) GetPlayers() []*Player {
+func (x *UpdateInvitesRequest) GetInvite() []string {
 	if x != nil {
-		return x.Players
+		return x.Invite
 	}
 	return nil
 }
 
-func (x *GameInfo) GetWinningIds() []*Winners {
+func (x *UpdateInvitesRequest) GetRevoke() []string {
 	if x != nil {
-		return x.WinningIds
+		return x.Revoke
 	}
 	return nil
 }
 
-type Winners struct {
+type UpdateInvitesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,10,rep,name=ids,proto3" json:"ids,omitempty"`
+	// everyone invited to the table after the update
+	Invited []string `protobuf:"bytes,10,rep,name=invited,proto3" json:"invited,omitempty"`
 }
 
-func (x *Winners) Reset() {
-	*x = Winners{}
+func (x *UpdateInvitesResponse) Reset() {
+	*x = UpdateInvitesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_poker_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,13 +1676,13 @@ func (x *Winners) Reset() {
 	}
 }
 
-func (x *Winners) String() string {
+func (x *UpdateInvitesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Winners) ProtoMessage() {}
+func (*UpdateInvitesResponse) ProtoMessage() {}
 
-func (x *Winners) ProtoReflect() protoreflect.Message {
+func (x *UpdateInvitesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_poker_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1253,53 +1694,1586 @@ func (x *Winners) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Winners.ProtoReflect.Descriptor instead.
-func (*Winners) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdateInvitesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateInvitesResponse) GetInvited() []string {
+	if x != nil {
+		return x.Invited
+	}
+	return nil
+}
+
+type CreateTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	Config     *TableConfig `protobuf:"bytes,20,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *CreateTableRequest) Reset() {
+	*x = CreateTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableRequest) ProtoMessage() {}
+
+func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTableRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *CreateTableRequest) GetConfig() *TableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableID   string `protobuf:"bytes,10,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	TableName string `protobuf:"bytes,20,opt,name=tableName,proto3" json:"tableName,omitempty"`
+}
+
+func (x *CreateTableResponse) Reset() {
+	*x = CreateTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableResponse) ProtoMessage() {}
+
+func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTableResponse) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+func (x *CreateTableResponse) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+// TableFilter selects tables in the lobby, unset fields match all tables
+type TableFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variants          []GameVariant      `protobuf:"varint,10,rep,packed,name=variants,proto3,enum=poker.GameVariant" json:"variants,omitempty"`
+	BettingStructures []BettingStructure `protobuf:"varint,20,rep,packed,name=bettingStructures,proto3,enum=poker.BettingStructure" json:"bettingStructures,omitempty"`
+	MinBigBlind       int64              `protobuf:"varint,30,opt,name=minBigBlind,proto3" json:"minBigBlind,omitempty"`
+	MaxBigBlind       int64              `protobuf:"varint,40,opt,name=maxBigBlind,proto3" json:"maxBigBlind,omitempty"`
+	// hide tables with no free seat
+	HideFull bool `protobuf:"varint,50,opt,name=hideFull,proto3" json:"hideFull,omitempty"`
+	// hide tables with no players
+	HideEmpty bool `protobuf:"varint,60,opt,name=hideEmpty,proto3" json:"hideEmpty,omitempty"`
+	// only cash tables, or only tournament tables
+	CashOnly       bool `protobuf:"varint,70,opt,name=cashOnly,proto3" json:"cashOnly,omitempty"`
+	TournamentOnly bool `protobuf:"varint,80,opt,name=tournamentOnly,proto3" json:"tournamentOnly,omitempty"`
+}
+
+func (x *TableFilter) Reset() {
+	*x = TableFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableFilter) ProtoMessage() {}
+
+func (x *TableFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableFilter.ProtoReflect.Descriptor instead.
+func (*TableFilter) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TableFilter) GetVariants() []GameVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *TableFilter) GetBettingStructures() []BettingStructure {
+	if x != nil {
+		return x.BettingStructures
+	}
+	return nil
+}
+
+func (x *TableFilter) GetMinBigBlind() int64 {
+	if x != nil {
+		return x.MinBigBlind
+	}
+	return 0
+}
+
+func (x *TableFilter) GetMaxBigBlind() int64 {
+	if x != nil {
+		return x.MaxBigBlind
+	}
+	return 0
+}
+
+func (x *TableFilter) GetHideFull() bool {
+	if x != nil {
+		return x.HideFull
+	}
+	return false
+}
+
+func (x *TableFilter) GetHideEmpty() bool {
+	if x != nil {
+		return x.HideEmpty
+	}
+	return false
+}
+
+func (x *TableFilter) GetCashOnly() bool {
+	if x != nil {
+		return x.CashOnly
+	}
+	return false
+}
+
+func (x *TableFilter) GetTournamentOnly() bool {
+	if x != nil {
+		return x.TournamentOnly
+	}
+	return false
+}
+
+type ListTablesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	Filter     *TableFilter `protobuf:"bytes,20,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTablesRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *ListTablesRequest) GetFilter() *TableFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListTablesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*TableSummary `protobuf:"bytes,10,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesResponse) ProtoMessage() {}
+
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTablesResponse) GetTables() []*TableSummary {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// TableSummary describes a table in the lobby
+type TableSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableID          string           `protobuf:"bytes,10,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	TableName        string           `protobuf:"bytes,20,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	Variant          GameVariant      `protobuf:"varint,30,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	BettingStructure BettingStructure `protobuf:"varint,40,opt,name=bettingStructure,proto3,enum=poker.BettingStructure" json:"bettingStructure,omitempty"`
+	SmallBlind       int64            `protobuf:"varint,50,opt,name=smallBlind,proto3" json:"smallBlind,omitempty"`
+	BigBlind         int64            `protobuf:"varint,60,opt,name=bigBlind,proto3" json:"bigBlind,omitempty"`
+	Ante             int64            `protobuf:"varint,70,opt,name=ante,proto3" json:"ante,omitempty"`
+	Buyin            int64            `protobuf:"varint,80,opt,name=buyin,proto3" json:"buyin,omitempty"` // maximum buyin
+	MinBuyin         int64            `protobuf:"varint,85,opt,name=minBuyin,proto3" json:"minBuyin,omitempty"`
+	Players          int64            `protobuf:"varint,90,opt,name=players,proto3" json:"players,omitempty"`
+	MaxPlayers       int64            `protobuf:"varint,100,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	AvailableToJoin  bool             `protobuf:"varint,105,opt,name=availableToJoin,proto3" json:"availableToJoin,omitempty"`
+	// average pot of the recent hands
+	AveragePot int64     `protobuf:"varint,110,opt,name=averagePot,proto3" json:"averagePot,omitempty"`
+	GameState  GameState `protobuf:"varint,120,opt,name=gameState,proto3,enum=poker.GameState" json:"gameState,omitempty"`
+	Tournament bool      `protobuf:"varint,130,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *TableSummary) Reset() {
+	*x = TableSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSummary) ProtoMessage() {}
+
+func (x *TableSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSummary.ProtoReflect.Descriptor instead.
+func (*TableSummary) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TableSummary) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+func (x *TableSummary) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *TableSummary) GetVariant() GameVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return GameVariant_GameVariantTexasHoldem
+}
+
+func (x *TableSummary) GetBettingStructure() BettingStructure {
+	if x != nil {
+		return x.BettingStructure
+	}
+	return BettingStructure_BettingStructureNoLimit
+}
+
+func (x *TableSummary) GetSmallBlind() int64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *TableSummary) GetBigBlind() int64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *TableSummary) GetAnte() int64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *TableSummary) GetBuyin() int64 {
+	if x != nil {
+		return x.Buyin
+	}
+	return 0
+}
+
+func (x *TableSummary) GetMinBuyin() int64 {
+	if x != nil {
+		return x.MinBuyin
+	}
+	return 0
+}
+
+func (x *TableSummary) GetPlayers() int64 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *TableSummary) GetMaxPlayers() int64 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *TableSummary) GetAvailableToJoin() bool {
+	if x != nil {
+		return x.AvailableToJoin
+	}
+	return false
+}
+
+func (x *TableSummary) GetAveragePot() int64 {
+	if x != nil {
+		return x.AveragePot
+	}
+	return 0
+}
+
+func (x *TableSummary) GetGameState() GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return GameState_GameStateWaitingPlayers
+}
+
+func (x *TableSummary) GetTournament() bool {
+	if x != nil {
+		return x.Tournament
+	}
+	return false
+}
+
+// JoinWaitlistRequest queues for a specific table if tableID is set, otherwise for any table matching the filter
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	TableID    string       `protobuf:"bytes,20,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	Filter     *TableFilter `protobuf:"bytes,30,opt,name=filter,proto3" json:"filter,omitempty"`
+	// join options once a seat is taken
+	Password    string `protobuf:"bytes,40,opt,name=password,proto3" json:"password,omitempty"`
+	BuyinAmount int64  `protobuf:"varint,50,opt,name=buyinAmount,proto3" json:"buyinAmount,omitempty"`
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *JoinWaitlistRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetFilter() *TableFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetBuyinAmount() int64 {
+	if x != nil {
+		return x.BuyinAmount
+	}
+	return 0
+}
+
+// WaitlistUpdate is sent whenever the player's place in the waitlist changes
+type WaitlistUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// position in the queue, 1 is next in line; 0 while a seat is offered
+	Position int64 `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	// the seat offered to the player, ack the token with AckToken before it expires to take it
+	OfferTableID      string `protobuf:"bytes,20,opt,name=offerTableID,proto3" json:"offerTableID,omitempty"`
+	OfferTableName    string `protobuf:"bytes,25,opt,name=offerTableName,proto3" json:"offerTableName,omitempty"`
+	OfferToken        string `protobuf:"bytes,30,opt,name=offerToken,proto3" json:"offerToken,omitempty"`
+	OfferExpiresInSec int64  `protobuf:"varint,40,opt,name=offerExpiresInSec,proto3" json:"offerExpiresInSec,omitempty"`
+	// set once the player took the seat, the stream ends after this
+	Seated *JoinTableResponse `protobuf:"bytes,50,opt,name=seated,proto3" json:"seated,omitempty"`
+}
+
+func (x *WaitlistUpdate) Reset() {
+	*x = WaitlistUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistUpdate) ProtoMessage() {}
+
+func (x *WaitlistUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistUpdate.ProtoReflect.Descriptor instead.
+func (*WaitlistUpdate) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WaitlistUpdate) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistUpdate) GetOfferTableID() string {
+	if x != nil {
+		return x.OfferTableID
+	}
+	return ""
+}
+
+func (x *WaitlistUpdate) GetOfferTableName() string {
+	if x != nil {
+		return x.OfferTableName
+	}
+	return ""
+}
+
+func (x *WaitlistUpdate) GetOfferToken() string {
+	if x != nil {
+		return x.OfferToken
+	}
+	return ""
+}
+
+func (x *WaitlistUpdate) GetOfferExpiresInSec() int64 {
+	if x != nil {
+		return x.OfferExpiresInSec
+	}
+	return 0
+}
+
+func (x *WaitlistUpdate) GetSeated() *JoinTableResponse {
+	if x != nil {
+		return x.Seated
+	}
+	return nil
+}
+
+// WatchRequest is sent to watch a table as a spectator
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo *ClientInfo `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	TableID    string      `protobuf:"bytes,20,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	// delay the updates by this much, the server may enforce a longer delay
+	DelaySec int64 `protobuf:"varint,30,opt,name=delaySec,proto3" json:"delaySec,omitempty"`
+	// password of a private table, not needed if invited
+	Password string `protobuf:"bytes,40,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetDelaySec() int64 {
+	if x != nil {
+		return x.DelaySec
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// PlayRequest is sent to register for the GameData streaming response
+type PlayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientInfo   *ClientInfo  `protobuf:"bytes,10,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	PlayerAction PlayerAction `protobuf:"varint,50,opt,name=playerAction,proto3,enum=poker.PlayerAction" json:"playerAction,omitempty"`
+}
+
+func (x *PlayRequest) Reset() {
+	*x = PlayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayRequest) ProtoMessage() {}
+
+func (x *PlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayRequest.ProtoReflect.Descriptor instead.
+func (*PlayRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlayRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *PlayRequest) GetPlayerAction() PlayerAction {
+	if x != nil {
+		return x.PlayerAction
+	}
+	return PlayerAction_PlayerActionNone
+}
+
+// ClientInfo is sent to the server with every RPC request, contains common data
+// Not all fields are filled in for all requests
+type ClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerID       string `protobuf:"bytes,10,opt,name=playerID,proto3" json:"playerID,omitempty"`
+	PlayerName     string `protobuf:"bytes,20,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	PlayerUsername string `protobuf:"bytes,30,opt,name=playerUsername,proto3" json:"playerUsername,omitempty"`
+	TableID        string `protobuf:"bytes,40,opt,name=tableID,proto3" json:"tableID,omitempty"`
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClientInfo) GetPlayerID() string {
+	if x != nil {
+		return x.PlayerID
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetPlayerUsername() string {
+	if x != nil {
+		return x.PlayerUsername
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+// GameInfo contains all the game info
+type GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableName          string           `protobuf:"bytes,10,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	TableID            string           `protobuf:"bytes,20,opt,name=tableID,proto3" json:"tableID,omitempty"`
+	Variant            GameVariant      `protobuf:"varint,25,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	BettingStructure   BettingStructure `protobuf:"varint,27,opt,name=bettingStructure,proto3,enum=poker.BettingStructure" json:"bettingStructure,omitempty"`
+	GameState          GameState        `protobuf:"varint,30,opt,name=gameState,proto3,enum=poker.GameState" json:"gameState,omitempty"`
+	GameStartsInSec    int64            `protobuf:"varint,40,opt,name=gameStartsInSec,proto3" json:"gameStartsInSec,omitempty"`
+	GameStartsInMaxSec int64            `protobuf:"varint,45,opt,name=gameStartsInMaxSec,proto3" json:"gameStartsInMaxSec,omitempty"`
+	AckToken           string           `protobuf:"bytes,50,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
+	CommunityCards     *CommunityCards  `protobuf:"bytes,60,opt,name=CommunityCards,proto3" json:"CommunityCards,omitempty"`
+	MaxPlayers         int64            `protobuf:"varint,100,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	MinPlayers         int64            `protobuf:"varint,110,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
+	BigBlind           int64            `protobuf:"varint,120,opt,name=bigBlind,proto3" json:"bigBlind,omitempty"`
+	SmallBlind         int64            `protobuf:"varint,125,opt,name=smallBlind,proto3" json:"smallBlind,omitempty"`
+	Ante               int64            `protobuf:"varint,127,opt,name=ante,proto3" json:"ante,omitempty"`
+	Buyin              int64            `protobuf:"varint,130,opt,name=buyin,proto3" json:"buyin,omitempty"` // maximum buyin
+	MinBuyin           int64            `protobuf:"varint,135,opt,name=minBuyin,proto3" json:"minBuyin,omitempty"`
+	ButtonPosition     int64            `protobuf:"varint,140,opt,name=buttonPosition,proto3" json:"buttonPosition,omitempty"`
+	SmallBlindPosition int64            `protobuf:"varint,150,opt,name=smallBlindPosition,proto3" json:"smallBlindPosition,omitempty"`
+	BigBlindPosition   int64            `protobuf:"varint,160,opt,name=bigBlindPosition,proto3" json:"bigBlindPosition,omitempty"`
+	// All players, no confidential info
+	Players    []*Player  `protobuf:"bytes,170,rep,name=players,proto3" json:"players,omitempty"`
+	WinningIds []*Winners `protobuf:"bytes,180,rep,name=winning_ids,json=winningIds,proto3" json:"winning_ids,omitempty"`
+	// Only set at tournament tables
+	Tournament *TournamentInfo `protobuf:"bytes,190,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *GameInfo) Reset() {
+	*x = GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo) ProtoMessage() {}
+
+func (x *GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GameInfo) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GameInfo) GetTableID() string {
+	if x != nil {
+		return x.TableID
+	}
+	return ""
+}
+
+func (x *GameInfo) GetVariant() GameVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return GameVariant_GameVariantTexasHoldem
+}
+
+func (x *GameInfo) GetBettingStructure() BettingStructure {
+	if x != nil {
+		return x.BettingStructure
+	}
+	return BettingStructure_BettingStructureNoLimit
+}
+
+func (x *GameInfo) GetGameState() GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return GameState_GameStateWaitingPlayers
+}
+
+func (x *GameInfo) GetGameStartsInSec() int64 {
+	if x != nil {
+		return x.GameStartsInSec
+	}
+	return 0
+}
+
+func (x *GameInfo) GetGameStartsInMaxSec() int64 {
+	if x != nil {
+		return x.GameStartsInMaxSec
+	}
+	return 0
+}
+
+func (x *GameInfo) GetAckToken() string {
+	if x != nil {
+		return x.AckToken
+	}
+	return ""
+}
+
+func (x *GameInfo) GetCommunityCards() *CommunityCards {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *GameInfo) GetMaxPlayers() int64 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *GameInfo) GetMinPlayers() int64 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *GameInfo) GetBigBlind() int64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *GameInfo) GetSmallBlind() int64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *GameInfo) GetAnte() int64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *GameInfo) GetBuyin() int64 {
+	if x != nil {
+		return x.Buyin
+	}
+	return 0
+}
+
+func (x *GameInfo) GetMinBuyin() int64 {
+	if x != nil {
+		return x.MinBuyin
+	}
+	return 0
+}
+
+func (x *GameInfo) GetButtonPosition() int64 {
+	if x != nil {
+		return x.ButtonPosition
+	}
+	return 0
+}
+
+func (x *GameInfo) GetSmallBlindPosition() int64 {
+	if x != nil {
+		return x.SmallBlindPosition
+	}
+	return 0
+}
+
+func (x *GameInfo) GetBigBlindPosition() int64 {
+	if x != nil {
+		return x.BigBlindPosition
+	}
+	return 0
+}
+
+func (x *GameInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameInfo) GetWinningIds() []*Winners {
+	if x != nil {
+		return x.WinningIds
+	}
+	return nil
+}
+
+func (x *GameInfo) GetTournament() *TournamentInfo {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+// TournamentInfo describes the tournament played at a table
+type TournamentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level          int64   `protobuf:"varint,10,opt,name=level,proto3" json:"level,omitempty"`                   // current blind level, starting at 1
+	LevelEndsInSec int64   `protobuf:"varint,20,opt,name=levelEndsInSec,proto3" json:"levelEndsInSec,omitempty"` // time left in the level, if levels advance on a timer
+	LevelHandsLeft int64   `protobuf:"varint,25,opt,name=levelHandsLeft,proto3" json:"levelHandsLeft,omitempty"` // hands left in the level, if levels advance on a hand count
+	NextSmallBlind int64   `protobuf:"varint,30,opt,name=nextSmallBlind,proto3" json:"nextSmallBlind,omitempty"`
+	NextBigBlind   int64   `protobuf:"varint,31,opt,name=nextBigBlind,proto3" json:"nextBigBlind,omitempty"`
+	NextAnte       int64   `protobuf:"varint,32,opt,name=nextAnte,proto3" json:"nextAnte,omitempty"`
+	Entrants       int64   `protobuf:"varint,40,opt,name=entrants,proto3" json:"entrants,omitempty"`
+	PlayersLeft    int64   `protobuf:"varint,50,opt,name=playersLeft,proto3" json:"playersLeft,omitempty"`
+	StartingChips  int64   `protobuf:"varint,60,opt,name=startingChips,proto3" json:"startingChips,omitempty"`
+	PrizePool      int64   `protobuf:"varint,70,opt,name=prizePool,proto3" json:"prizePool,omitempty"`
+	Payouts        []int64 `protobuf:"varint,80,rep,packed,name=payouts,proto3" json:"payouts,omitempty"` // bank money paid to 1st, 2nd, ... place
+	// players that finished the tournament, best place first
+	Results []*TournamentFinish `protobuf:"bytes,90,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TournamentInfo) Reset() {
+	*x = TournamentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentInfo) ProtoMessage() {}
+
+func (x *TournamentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentInfo.ProtoReflect.Descriptor instead.
+func (*TournamentInfo) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TournamentInfo) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetLevelEndsInSec() int64 {
+	if x != nil {
+		return x.LevelEndsInSec
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetLevelHandsLeft() int64 {
+	if x != nil {
+		return x.LevelHandsLeft
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetNextSmallBlind() int64 {
+	if x != nil {
+		return x.NextSmallBlind
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetNextBigBlind() int64 {
+	if x != nil {
+		return x.NextBigBlind
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetNextAnte() int64 {
+	if x != nil {
+		return x.NextAnte
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetEntrants() int64 {
+	if x != nil {
+		return x.Entrants
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetPlayersLeft() int64 {
+	if x != nil {
+		return x.PlayersLeft
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetStartingChips() int64 {
+	if x != nil {
+		return x.StartingChips
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetPrizePool() int64 {
+	if x != nil {
+		return x.PrizePool
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetPayouts() []int64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *TournamentInfo) GetResults() []*TournamentFinish {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TournamentFinish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerID string `protobuf:"bytes,10,opt,name=playerID,proto3" json:"playerID,omitempty"`
+	Name     string `protobuf:"bytes,20,opt,name=name,proto3" json:"name,omitempty"`
+	Place    int64  `protobuf:"varint,30,opt,name=place,proto3" json:"place,omitempty"`
+	Prize    int64  `protobuf:"varint,40,opt,name=prize,proto3" json:"prize,omitempty"`
+}
+
+func (x *TournamentFinish) Reset() {
+	*x = TournamentFinish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentFinish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentFinish) ProtoMessage() {}
+
+func (x *TournamentFinish) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentFinish.ProtoReflect.Descriptor instead.
+func (*TournamentFinish) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TournamentFinish) GetPlayerID() string {
+	if x != nil {
+		return x.PlayerID
+	}
+	return ""
+}
+
+func (x *TournamentFinish) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentFinish) GetPlace() int64 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *TournamentFinish) GetPrize() int64 {
+	if x != nil {
+		return x.Prize
+	}
+	return 0
+}
+
+type Winners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,10,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *Winners) Reset() {
+	*x = Winners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Winners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Winners) ProtoMessage() {}
+
+func (x *Winners) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Winners.ProtoReflect.Descriptor instead.
+func (*Winners) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Winners) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// GameData is sent to the client, it tells the client when it's their turn and
+// provides the current state
+type GameData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info                *GameInfo `protobuf:"bytes,10,opt,name=info,proto3" json:"info,omitempty"`
+	WaitTurnID          string    `protobuf:"bytes,20,opt,name=waitTurnID,proto3" json:"waitTurnID,omitempty"`                    // the ID of the player whose turn it is
+	WaitTurnName        string    `protobuf:"bytes,30,opt,name=waitTurnName,proto3" json:"waitTurnName,omitempty"`                // the name of the player whose turn it is
+	WaitTurnNum         int64     `protobuf:"varint,35,opt,name=waitTurnNum,proto3" json:"waitTurnNum,omitempty"`                 // the turn count of the player whose turn it is
+	WaitTurnTimeLeftSec int64     `protobuf:"varint,40,opt,name=waitTurnTimeLeftSec,proto3" json:"waitTurnTimeLeftSec,omitempty"` // turn time left for player
+	WaitTurnTimeMaxSec  int64     `protobuf:"varint,45,opt,name=waitTurnTimeMaxSec,proto3" json:"waitTurnTimeMaxSec,omitempty"`
+	// time bank left for the player whose turn it is, used once the turn time runs out
+	WaitTurnTimeBankSec int64  `protobuf:"varint,46,opt,name=waitTurnTimeBankSec,proto3" json:"waitTurnTimeBankSec,omitempty"`
+	PlayerID            string `protobuf:"bytes,50,opt,name=playerID,proto3" json:"playerID,omitempty"` // the ID of the calling player
+	// Only set when it is the calling player's turn, computed by the server from
+	// the table's betting structure
+	AllowedActions []PlayerAction `protobuf:"varint,60,rep,packed,name=allowedActions,proto3,enum=poker.PlayerAction" json:"allowedActions,omitempty"` // actions the player may take
+	CallAmount     int64          `protobuf:"varint,61,opt,name=callAmount,proto3" json:"callAmount,omitempty"`                                        // amount needed to call, capped at the stack
+	MinBet         int64          `protobuf:"varint,62,opt,name=minBet,proto3" json:"minBet,omitempty"`                                                // smallest betAmount accepted for a bet or raise
+	MaxBet         int64          `protobuf:"varint,63,opt,name=maxBet,proto3" json:"maxBet,omitempty"`                                                // largest betAmount accepted for a bet or raise
+	// set after the calling player was moved to this table from another table
+	// of a multi-table tournament, until the player acks a token at this table
+	MovedFromTableID string `protobuf:"bytes,70,opt,name=movedFromTableID,proto3" json:"movedFromTableID,omitempty"`
+	// pre-action queued by the calling player, and the call it was queued for
+	PreAction           PreAction `protobuf:"varint,80,opt,name=preAction,proto3,enum=poker.PreAction" json:"preAction,omitempty"`
+	PreActionCallAmount int64     `protobuf:"varint,81,opt,name=preActionCallAmount,proto3" json:"preActionCallAmount,omitempty"`
+	// events since the previous update, and the seq of the last event this update reflects
+	Events []*TableEvent `protobuf:"bytes,90,rep,name=events,proto3" json:"events,omitempty"`
+	Seq    int64         `protobuf:"varint,91,opt,name=seq,proto3" json:"seq,omitempty"`
+	// set when the events since the previous update are not included, the update is a fresh snapshot
+	Snapshot bool `protobuf:"varint,92,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// calling player, includes confidential info
+	Player *Player `protobuf:"bytes,100,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *GameData) Reset() {
+	*x = GameData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_poker_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameData) ProtoMessage() {}
+
+func (x *GameData) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameData.ProtoReflect.Descriptor instead.
+func (*GameData) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameData) GetInfo() *GameInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GameData) GetWaitTurnID() string {
+	if x != nil {
+		return x.WaitTurnID
+	}
+	return ""
+}
+
+func (x *GameData) GetWaitTurnName() string {
+	if x != nil {
+		return x.WaitTurnName
+	}
+	return ""
+}
+
+func (x *GameData) GetWaitTurnNum() int64 {
+	if x != nil {
+		return x.WaitTurnNum
+	}
+	return 0
+}
+
+func (x *GameData) GetWaitTurnTimeLeftSec() int64 {
+	if x != nil {
+		return x.WaitTurnTimeLeftSec
+	}
+	return 0
+}
+
+func (x *GameData) GetWaitTurnTimeMaxSec() int64 {
+	if x != nil {
+		return x.WaitTurnTimeMaxSec
+	}
+	return 0
+}
+
+func (x *GameData) GetWaitTurnTimeBankSec() int64 {
+	if x != nil {
+		return x.WaitTurnTimeBankSec
+	}
+	return 0
+}
+
+func (x *GameData) GetPlayerID() string {
+	if x != nil {
+		return x.PlayerID
+	}
+	return ""
+}
+
+func (x *GameData) GetAllowedActions() []PlayerAction {
+	if x != nil {
+		return x.AllowedActions
+	}
+	return nil
+}
+
+func (x *GameData) GetCallAmount() int64 {
+	if x != nil {
+		return x.CallAmount
+	}
+	return 0
+}
+
+func (x *GameData) GetMinBet() int64 {
+	if x != nil {
+		return x.MinBet
+	}
+	return 0
+}
+
+func (x *GameData) GetMaxBet() int64 {
+	if x != nil {
+		return x.MaxBet
+	}
+	return 0
+}
+
+func (x *GameData) GetMovedFromTableID() string {
+	if x != nil {
+		return x.MovedFromTableID
+	}
+	return ""
+}
+
+func (x *GameData) GetPreAction() PreAction {
+	if x != nil {
+		return x.PreAction
+	}
+	return PreAction_PreActionNone
+}
+
+func (x *GameData) GetPreActionCallAmount() int64 {
+	if x != nil {
+		return x.PreActionCallAmount
+	}
+	return 0
+}
+
+func (x *GameData) GetEvents() []*TableEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GameData) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GameData) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
 }
 
-func (x *Winners) GetIds() []string {
+func (x *GameData) GetPlayer() *Player {
 	if x != nil {
-		return x.Ids
+		return x.Player
 	}
 	return nil
 }
 
-// GameData is sent to the client, it tells the client when it's their turn and
-// provides the current state
-type GameData struct {
+// TableEvent is one change at the table, numbered in order by seq
+type TableEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info                *GameInfo `protobuf:"bytes,10,opt,name=info,proto3" json:"info,omitempty"`
-	WaitTurnID          string    `protobuf:"bytes,20,opt,name=waitTurnID,proto3" json:"waitTurnID,omitempty"`                    // the ID of the player whose turn it is
-	WaitTurnName        string    `protobuf:"bytes,30,opt,name=waitTurnName,proto3" json:"waitTurnName,omitempty"`                // the name of the player whose turn it is
-	WaitTurnNum         int64     `protobuf:"varint,35,opt,name=waitTurnNum,proto3" json:"waitTurnNum,omitempty"`                 // the turn count of the player whose turn it is
-	WaitTurnTimeLeftSec int64     `protobuf:"varint,40,opt,name=waitTurnTimeLeftSec,proto3" json:"waitTurnTimeLeftSec,omitempty"` // turn time left for player
-	WaitTurnTimeMaxSec  int64     `protobuf:"varint,45,opt,name=waitTurnTimeMaxSec,proto3" json:"waitTurnTimeMaxSec,omitempty"`
-	PlayerID            string    `protobuf:"bytes,50,opt,name=playerID,proto3" json:"playerID,omitempty"` // the ID of the calling player
-	// calling player, includes confidential info
-	Player *Player `protobuf:"bytes,100,opt,name=player,proto3" json:"player,omitempty"`
-}
-
-func (x *GameData) Reset() {
-	*x = GameData{}
+	Seq  int64          `protobuf:"varint,10,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type TableEventType `protobuf:"varint,20,opt,name=type,proto3,enum=poker.TableEventType" json:"type,omitempty"`
+	// the player the event is about, if any
+	PlayerID string       `protobuf:"bytes,30,opt,name=playerID,proto3" json:"playerID,omitempty"`
+	Action   PlayerAction `protobuf:"varint,40,opt,name=action,proto3,enum=poker.PlayerAction" json:"action,omitempty"`
+	// money put in by the player, or awarded to the player
+	Amount int64 `protobuf:"varint,50,opt,name=amount,proto3" json:"amount,omitempty"`
+	AllIn  bool  `protobuf:"varint,55,opt,name=allIn,proto3" json:"allIn,omitempty"`
+	// hole cards are only sent to the player they are dealt to
+	Cards     []*Card   `protobuf:"bytes,60,rep,name=cards,proto3" json:"cards,omitempty"`
+	GameState GameState `protobuf:"varint,70,opt,name=gameState,proto3,enum=poker.GameState" json:"gameState,omitempty"`
+	// the pot awarded, 0 for the main pot and 1.. for the side pots
+	Pot int64 `protobuf:"varint,80,opt,name=pot,proto3" json:"pot,omitempty"`
+}
+
+func (x *TableEvent) Reset() {
+	*x = TableEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[14]
+		mi := &file_poker_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameData) String() string {
+func (x *TableEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameData) ProtoMessage() {}
+func (*TableEvent) ProtoMessage() {}
 
-func (x *GameData) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[14]
+func (x *TableEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,65 +3284,72 @@ func (x *GameData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameData.ProtoReflect.Descriptor instead.
-func (*GameData) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TableEvent.ProtoReflect.Descriptor instead.
+func (*TableEvent) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GameData) GetInfo() *GameInfo {
+func (x *TableEvent) GetSeq() int64 {
 	if x != nil {
-		return x.Info
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *GameData) GetWaitTurnID() string {
+func (x *TableEvent) GetType() TableEventType {
 	if x != nil {
-		return x.WaitTurnID
+		return x.Type
 	}
-	return ""
+	return TableEventType_TableEventNone
 }
 
-func (x *GameData) GetWaitTurnName() string {
+func (x *TableEvent) GetPlayerID() string {
 	if x != nil {
-		return x.WaitTurnName
+		return x.PlayerID
 	}
 	return ""
 }
 
-func (x *GameData) GetWaitTurnNum() int64 {
+func (x *TableEvent) GetAction() PlayerAction {
 	if x != nil {
-		return x.WaitTurnNum
+		return x.Action
 	}
-	return 0
+	return PlayerAction_PlayerActionNone
 }
 
-func (x *GameData) GetWaitTurnTimeLeftSec() int64 {
+func (x *TableEvent) GetAmount() int64 {
 	if x != nil {
-		return x.WaitTurnTimeLeftSec
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GameData) GetWaitTurnTimeMaxSec() int64 {
+func (x *TableEvent) GetAllIn() bool {
 	if x != nil {
-		return x.WaitTurnTimeMaxSec
+		return x.AllIn
 	}
-	return 0
+	return false
 }
 
-func (x *GameData) GetPlayerID() string {
+func (x *TableEvent) GetCards() []*Card {
 	if x != nil {
-		return x.PlayerID
+		return x.Cards
 	}
-	return ""
+	return nil
 }
 
-func (x *GameData) GetPlayer() *Player {
+func (x *TableEvent) GetGameState() GameState {
 	if x != nil {
-		return x.Player
+		return x.GameState
 	}
-	return nil
+	return GameState_GameStateWaitingPlayers
+}
+
+func (x *TableEvent) GetPot() int64 {
+	if x != nil {
+		return x.Pot
+	}
+	return 0
 }
 
 type Player struct {
@@ -1390,12 +3371,14 @@ type Player struct {
 	Hand       []*Card     `protobuf:"bytes,70,rep,name=hand,proto3" json:"hand,omitempty"`
 	Combo      string      `protobuf:"bytes,80,opt,name=combo,proto3" json:"combo,omitempty"`
 	LastAction *LastAction `protobuf:"bytes,90,opt,name=lastAction,proto3" json:"lastAction,omitempty"`
+	// blinds missed while sitting out, posted when the player sits back in
+	MissedBlinds int64 `protobuf:"varint,100,opt,name=missedBlinds,proto3" json:"missedBlinds,omitempty"`
 }
 
 func (x *Player) Reset() {
 	*x = Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[15]
+		mi := &file_poker_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1408,7 +3391,7 @@ func (x *Player) String() string {
 func (*Player) ProtoMessage() {}
 
 func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[15]
+	mi := &file_poker_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +3404,7 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Player.ProtoReflect.Descriptor instead.
 func (*Player) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{15}
+	return file_poker_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Player) GetName() string {
@@ -1487,6 +3470,13 @@ func (x *Player) GetLastAction() *LastAction {
 	return nil
 }
 
+func (x *Player) GetMissedBlinds() int64 {
+	if x != nil {
+		return x.MissedBlinds
+	}
+	return 0
+}
+
 type LastAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1499,7 +3489,7 @@ type LastAction struct {
 func (x *LastAction) Reset() {
 	*x = LastAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[16]
+		mi := &file_poker_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1512,7 +3502,7 @@ func (x *LastAction) String() string {
 func (*LastAction) ProtoMessage() {}
 
 func (x *LastAction) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[16]
+	mi := &file_poker_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,7 +3515,7 @@ func (x *LastAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastAction.ProtoReflect.Descriptor instead.
 func (*LastAction) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{16}
+	return file_poker_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *LastAction) GetAction() PlayerAction {
@@ -1566,7 +3556,7 @@ type PlayerMoney struct {
 func (x *PlayerMoney) Reset() {
 	*x = PlayerMoney{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[17]
+		mi := &file_poker_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1579,7 +3569,7 @@ func (x *PlayerMoney) String() string {
 func (*PlayerMoney) ProtoMessage() {}
 
 func (x *PlayerMoney) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[17]
+	mi := &file_poker_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,7 +3582,7 @@ func (x *PlayerMoney) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerMoney.ProtoReflect.Descriptor instead.
 func (*PlayerMoney) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{17}
+	return file_poker_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PlayerMoney) GetBank() int64 {
@@ -1656,7 +3646,7 @@ type CommunityCards struct {
 func (x *CommunityCards) Reset() {
 	*x = CommunityCards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[18]
+		mi := &file_poker_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +3659,7 @@ func (x *CommunityCards) String() string {
 func (*CommunityCards) ProtoMessage() {}
 
 func (x *CommunityCards) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[18]
+	mi := &file_poker_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +3672,7 @@ func (x *CommunityCards) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommunityCards.ProtoReflect.Descriptor instead.
 func (*CommunityCards) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{18}
+	return file_poker_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CommunityCards) GetCard() []*Card {
@@ -1704,7 +3694,7 @@ type Card struct {
 func (x *Card) Reset() {
 	*x = Card{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_poker_proto_msgTypes[19]
+		mi := &file_poker_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1717,7 +3707,7 @@ func (x *Card) String() string {
 func (*Card) ProtoMessage() {}
 
 func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[19]
+	mi := &file_poker_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +3720,7 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Card.ProtoReflect.Descriptor instead.
 func (*Card) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{19}
+	return file_poker_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Card) GetSuite() CardSuit {
@@ -1758,33 +3748,60 @@ var file_poker_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x22, 0x12, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
-	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x0f,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x10, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a,
-	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x44, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a,
+	0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62,
+	0x75, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x75, 0x79, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x62, 0x75, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x74, 0x4f,
+	0x75, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18,
+	0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x62, 0x75, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x0f, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x10, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75,
+	0x79, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x75, 0x79, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x75,
+	0x79, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x75, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74,
 	0x22, 0x63, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12,
@@ -1808,171 +3825,511 @@ var file_poker_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
 	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
-	0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x18,
-	0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x9d,
-	0x05, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x06, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a,
+	0x10, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x48, 0x01, 0x52, 0x10, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c,
+	0x42, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6d, 0x61,
+	0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x42,
+	0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x50, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x6d, 0x69, 0x6e, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x42, 0x69, 0x67,
+	0x42, 0x6c, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x67,
+	0x61, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x57, 0x61, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x18, 0x8c, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x12, 0x13, 0x0a,
+	0x04, 0x61, 0x6e, 0x74, 0x65, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x6e,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x41, 0x6e,
+	0x74, 0x65, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x69, 0x67, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x41, 0x6e, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x64,
+	0x64, 0x6c, 0x65, 0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61,
+	0x64, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6b,
+	0x53, 0x65, 0x63, 0x18, 0xb4, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x18, 0xbe, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x66,
+	0x69, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61,
+	0x6e, 0x6b, 0x52, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x66, 0x69, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x12, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x63, 0x18,
+	0xd2, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x63, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x18, 0x28, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x22, 0x31, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x62, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x11, 0x62, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x69, 0x67, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x69, 0x64, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x3c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x64, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x73, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x63, 0x61, 0x73, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x50, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x72, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x90, 0x04, 0x0a, 0x0c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12,
+	0x43, 0x0a, 0x10, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x10, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x6e, 0x74, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x61, 0x6e, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x18, 0x50, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69,
+	0x6e, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x18, 0x55, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x42, 0x75, 0x79, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x4a,
+	0x6f, 0x69, 0x6e, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x67, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x13,
+	0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44,
+	0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x69,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x75, 0x79, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x53, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x61,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a,
-	0x12, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x4d, 0x61, 0x78,
-	0x53, 0x65, 0x63, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61,
-	0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69,
-	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x67, 0x42,
-	0x6c, 0x69, 0x6e, 0x64, 0x18, 0x78, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x67, 0x42,
-	0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69,
-	0x6e, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42,
-	0x6c, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x18, 0x82, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0e, 0x62,
-	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x8c, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x12, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69,
-	0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x12, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x10, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e,
-	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0xaa, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b,
-	0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0xb4, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x1b,
-	0x0a, 0x07, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x08,
-	0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a,
-	0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a,
-	0x0c, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x75, 0x6d,
-	0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e,
-	0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x65, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65,
-	0x66, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x18, 0x2d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x12, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d,
-	0x61, 0x78, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xa7, 0x02, 0x0a, 0x06, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x28, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70,
-	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18,
-	0x3c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64,
-	0x18, 0x46, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43,
-	0x61, 0x72, 0x64, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6d,
-	0x62, 0x6f, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12,
-	0x31, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x5a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x73, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12,
-	0x10, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f,
-	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x42, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x42,
-	0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x62,
-	0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x32, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x3c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x48, 0x61, 0x6e,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x46, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x31, 0x0a,
-	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12,
-	0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
-	0x22, 0x52, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
-	0x43, 0x61, 0x72, 0x64, 0x53, 0x75, 0x69, 0x74, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12,
-	0x23, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
-	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x2a, 0xab, 0x02, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x6c, 0x61, 0x79, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x65, 0x74, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x10, 0x07, 0x12, 0x18,
-	0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
-	0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x10, 0x09, 0x12,
-	0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x75, 0x79, 0x49, 0x6e, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x10, 0x0b, 0x2a, 0xb9, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1b, 0x0a, 0x17, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x69,
-	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x10, 0x00, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x61, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e,
-	0x64, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x10,
-	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c,
-	0x61, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x46, 0x6c, 0x6f, 0x70, 0x10, 0x05, 0x12, 0x18,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x79, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a,
+	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x44, 0x22, 0xf9, 0x06, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x10, 0x62, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x67,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x49, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x49, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x18, 0x2d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x6e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x78, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x6e, 0x74, 0x65, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x6e, 0x74, 0x65,
+	0x12, 0x15, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x62, 0x75, 0x79, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x75,
+	0x79, 0x69, 0x6e, 0x18, 0x87, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42,
+	0x75, 0x79, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0e, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62,
+	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x12, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x6d, 0x61, 0x6c,
+	0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x10, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x69, 0x67, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0xaa, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0xb4, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x77, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xbe, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xad, 0x03, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x45, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x53, 0x65, 0x63,
+	0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x4c, 0x65,
+	0x66, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x69, 0x67, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x74, 0x65,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x69, 0x70, 0x73, 0x18,
+	0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x50, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x6e, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x7a, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x22,
+	0x1b, 0x0a, 0x07, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xe0, 0x05, 0x0a,
+	0x08, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e,
+	0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x4e, 0x75,
+	0x6d, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72,
+	0x6e, 0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x53, 0x65, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4c,
+	0x65, 0x66, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75,
+	0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75,
+	0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x63, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x77, 0x61, 0x69, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x3d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x42, 0x65, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x42, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78,
+	0x42, 0x65, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x42, 0x65,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a,
+	0x09, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x13, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x51, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
+	0xa5, 0x02, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c,
+	0x49, 0x6e, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x50, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x70, 0x6f, 0x74, 0x22, 0xcb, 0x02, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x3c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18,
+	0x46, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62,
+	0x6f, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x31,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x5a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x6b,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x70, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x42, 0x65, 0x74, 0x54, 0x68,
+	0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x69, 0x6e, 0x42, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73, 0x48, 0x61, 0x6e,
+	0x64, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x65, 0x74, 0x54, 0x68, 0x69, 0x73,
+	0x48, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x31, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63,
+	0x61, 0x72, 0x64, 0x22, 0x52, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x75, 0x69, 0x74, 0x52, 0x05, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x2a, 0x80, 0x06, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x10, 0x04, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x74, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x10,
+	0x07, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x49, 0x6e,
+	0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x75, 0x79, 0x49, 0x6e, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x10, 0x0c, 0x12, 0x1a, 0x0a, 0x16,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x10, 0x0d, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x10, 0x0e, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x75, 0x79, 0x10, 0x10, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x11, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x74, 0x4f, 0x75,
+	0x74, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x74, 0x49, 0x6e, 0x10, 0x13, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61,
+	0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x14, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x10, 0x16, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x41, 0x6e, 0x74, 0x65, 0x10, 0x17, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x6d, 0x61, 0x6c,
+	0x6c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x10, 0x18, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x67, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x10, 0x19, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x1b,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x10, 0x1c, 0x2a, 0x86, 0x01, 0x0a, 0x09, 0x50,
+	0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x6c,
+	0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x72,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6e, 0x79, 0x10, 0x04,
+	0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c,
+	0x64, 0x10, 0x05, 0x2a, 0xda, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x67, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x46, 0x6c, 0x6f, 0x70, 0x10, 0x05, 0x12,
+	0x18, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x70, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x54, 0x75, 0x72,
+	0x6e, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x69, 0x76, 0x65, 0x72, 0x10, 0x08, 0x12, 0x18,
 	0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x69,
-	0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x70, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x54, 0x75, 0x72, 0x6e,
-	0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x69, 0x76, 0x65, 0x72, 0x10, 0x08, 0x12, 0x18, 0x0a,
-	0x14, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e,
-	0x67, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x0a, 0x2a, 0x8d,
+	0x6e, 0x67, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x0a, 0x12,
+	0x1f, 0x0a, 0x1b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x0b,
+	0x2a, 0x47, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x16, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x54, 0x65,
+	0x78, 0x61, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x6d, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47,
+	0x61, 0x6d, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4f, 0x6d, 0x61, 0x68, 0x61, 0x10, 0x01, 0x2a, 0x6d, 0x0a, 0x10, 0x42, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x4e, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x6c,
+	0x74, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x75, 0x72,
+	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x64, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x10, 0x06, 0x2a, 0xdd,
 	0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
 	0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66,
 	0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
@@ -1981,7 +4338,12 @@ var file_poker_proto_rawDesc = []byte{
 	0x6b, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79,
 	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x45, 0x6d, 0x70, 0x74, 0x79,
 	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x10, 0x04, 0x2a, 0x37,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x10, 0x04, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x10,
+	0x06, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x07, 0x2a, 0x37,
 	0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x53, 0x75, 0x69, 0x74, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x70,
 	0x61, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x6c, 0x75, 0x62, 0x10, 0x01, 0x12,
 	0x0b, 0x0a, 0x07, 0x44, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
@@ -1994,7 +4356,7 @@ var file_poker_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x65, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x65, 0x6e, 0x10, 0x08, 0x12, 0x08,
 	0x0a, 0x04, 0x4a, 0x61, 0x63, 0x6b, 0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x65,
 	0x6e, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x67, 0x10, 0x0b, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x63, 0x65, 0x10, 0x0c, 0x32, 0xbd, 0x02, 0x0a, 0x0b, 0x50, 0x6f, 0x6b, 0x65, 0x72,
+	0x03, 0x41, 0x63, 0x65, 0x10, 0x0c, 0x32, 0xd9, 0x05, 0x0a, 0x0b, 0x50, 0x6f, 0x6b, 0x65, 0x72,
 	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x08, 0x41, 0x63, 0x6b, 0x54, 0x6f, 0x6b,
 	0x65, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x6b, 0x54, 0x6f,
 	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6b,
@@ -2003,21 +4365,47 @@ var file_poker_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54,
 	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f,
 	0x6b, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x79, 0x12,
-	0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
-	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x54, 0x61, 0x6b, 0x65, 0x54,
-	0x75, 0x72, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x6b, 0x65,
-	0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f,
-	0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x54, 0x75, 0x6f, 0x76, 0x73, 0x6b, 0x79, 0x2f,
-	0x70, 0x65, 0x70, 0x70, 0x65, 0x72, 0x2d, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2d, 0x76, 0x32, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x12, 0x18, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x04, 0x50, 0x6c, 0x61,
+	0x79, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x54, 0x61, 0x6b,
+	0x65, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61,
+	0x6b, 0x65, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x4a,
+	0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x44, 0x61, 0x6e, 0x54, 0x75, 0x6f, 0x76, 0x73, 0x6b, 0x79, 0x2f, 0x70, 0x65, 0x70, 0x70,
+	0x65, 0x72, 0x2d, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2d, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2032,76 +4420,139 @@ func file_poker_proto_rawDescGZIP() []byte {
 	return file_poker_proto_rawDescData
 }
 
-var file_poker_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_poker_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_poker_proto_goTypes = []interface{}{
-	(PlayerAction)(0),          // 0: poker.PlayerAction
-	(GameState)(0),             // 1: poker.GameState
-	(PlayerState)(0),           // 2: poker.PlayerState
-	(CardSuit)(0),              // 3: poker.CardSuit
-	(CardRank)(0),              // 4: poker.CardRank
-	(*AckTokenRequest)(nil),    // 5: poker.AckTokenRequest
-	(*AckTokenResponse)(nil),   // 6: poker.AckTokenResponse
-	(*ActionOpts)(nil),         // 7: poker.ActionOpts
-	(*RegisterRequest)(nil),    // 8: poker.RegisterRequest
-	(*RegisterResponse)(nil),   // 9: poker.RegisterResponse
-	(*JoinTableRequest)(nil),   // 10: poker.JoinTableRequest
-	(*JoinTableResponse)(nil),  // 11: poker.JoinTableResponse
-	(*TakeTurnRequest)(nil),    // 12: poker.TakeTurnRequest
-	(*TakeTurnResponse)(nil),   // 13: poker.TakeTurnResponse
-	(*DisconnectResponse)(nil), // 14: poker.DisconnectResponse
-	(*PlayRequest)(nil),        // 15: poker.PlayRequest
-	(*ClientInfo)(nil),         // 16: poker.ClientInfo
-	(*GameInfo)(nil),           // 17: poker.GameInfo
-	(*Winners)(nil),            // 18: poker.Winners
-	(*GameData)(nil),           // 19: poker.GameData
-	(*Player)(nil),             // 20: poker.Player
-	(*LastAction)(nil),         // 21: poker.LastAction
-	(*PlayerMoney)(nil),        // 22: poker.PlayerMoney
-	(*CommunityCards)(nil),     // 23: poker.CommunityCards
-	(*Card)(nil),               // 24: poker.Card
+	(PlayerAction)(0),             // 0: poker.PlayerAction
+	(PreAction)(0),                // 1: poker.PreAction
+	(GameState)(0),                // 2: poker.GameState
+	(GameVariant)(0),              // 3: poker.GameVariant
+	(BettingStructure)(0),         // 4: poker.BettingStructure
+	(TableEventType)(0),           // 5: poker.TableEventType
+	(PlayerState)(0),              // 6: poker.PlayerState
+	(CardSuit)(0),                 // 7: poker.CardSuit
+	(CardRank)(0),                 // 8: poker.CardRank
+	(*AckTokenRequest)(nil),       // 9: poker.AckTokenRequest
+	(*AckTokenResponse)(nil),      // 10: poker.AckTokenResponse
+	(*ActionOpts)(nil),            // 11: poker.ActionOpts
+	(*AutoRebuy)(nil),             // 12: poker.AutoRebuy
+	(*RegisterRequest)(nil),       // 13: poker.RegisterRequest
+	(*RegisterResponse)(nil),      // 14: poker.RegisterResponse
+	(*JoinTableRequest)(nil),      // 15: poker.JoinTableRequest
+	(*JoinTableResponse)(nil),     // 16: poker.JoinTableResponse
+	(*TakeTurnRequest)(nil),       // 17: poker.TakeTurnRequest
+	(*TakeTurnResponse)(nil),      // 18: poker.TakeTurnResponse
+	(*DisconnectResponse)(nil),    // 19: poker.DisconnectResponse
+	(*TableConfig)(nil),           // 20: poker.TableConfig
+	(*UpdateInvitesRequest)(nil),  // 21: poker.UpdateInvitesRequest
+	(*UpdateInvitesResponse)(nil), // 22: poker.UpdateInvitesResponse
+	(*CreateTableRequest)(nil),    // 23: poker.CreateTableRequest
+	(*CreateTableResponse)(nil),   // 24: poker.CreateTableResponse
+	(*TableFilter)(nil),           // 25: poker.TableFilter
+	(*ListTablesRequest)(nil),     // 26: poker.ListTablesRequest
+	(*ListTablesResponse)(nil),    // 27: poker.ListTablesResponse
+	(*TableSummary)(nil),          // 28: poker.TableSummary
+	(*JoinWaitlistRequest)(nil),   // 29: poker.JoinWaitlistRequest
+	(*WaitlistUpdate)(nil),        // 30: poker.WaitlistUpdate
+	(*WatchRequest)(nil),          // 31: poker.WatchRequest
+	(*PlayRequest)(nil),           // 32: poker.PlayRequest
+	(*ClientInfo)(nil),            // 33: poker.ClientInfo
+	(*GameInfo)(nil),              // 34: poker.GameInfo
+	(*TournamentInfo)(nil),        // 35: poker.TournamentInfo
+	(*TournamentFinish)(nil),      // 36: poker.TournamentFinish
+	(*Winners)(nil),               // 37: poker.Winners
+	(*GameData)(nil),              // 38: poker.GameData
+	(*TableEvent)(nil),            // 39: poker.TableEvent
+	(*Player)(nil),                // 40: poker.Player
+	(*LastAction)(nil),            // 41: poker.LastAction
+	(*PlayerMoney)(nil),           // 42: poker.PlayerMoney
+	(*CommunityCards)(nil),        // 43: poker.CommunityCards
+	(*Card)(nil),                  // 44: poker.Card
 }
 var file_poker_proto_depIdxs = []int32{
-	16, // 0: poker.AckTokenRequest.clientInfo:type_name -> poker.ClientInfo
-	16, // 1: poker.RegisterRequest.clientInfo:type_name -> poker.ClientInfo
-	0,  // 2: poker.RegisterRequest.playerAction:type_name -> poker.PlayerAction
-	16, // 3: poker.JoinTableRequest.clientInfo:type_name -> poker.ClientInfo
-	0,  // 4: poker.JoinTableRequest.playerAction:type_name -> poker.PlayerAction
-	16, // 5: poker.TakeTurnRequest.clientInfo:type_name -> poker.ClientInfo
-	0,  // 6: poker.TakeTurnRequest.playerAction:type_name -> poker.PlayerAction
-	7,  // 7: poker.TakeTurnRequest.actionOpts:type_name -> poker.ActionOpts
-	16, // 8: poker.PlayRequest.clientInfo:type_name -> poker.ClientInfo
-	0,  // 9: poker.PlayRequest.playerAction:type_name -> poker.PlayerAction
-	1,  // 10: poker.GameInfo.gameState:type_name -> poker.GameState
-	23, // 11: poker.GameInfo.CommunityCards:type_name -> poker.CommunityCards
-	20, // 12: poker.GameInfo.players:type_name -> poker.Player
-	18, // 13: poker.GameInfo.winning_ids:type_name -> poker.Winners
-	17, // 14: poker.GameData.info:type_name -> poker.GameInfo
-	20, // 15: poker.GameData.player:type_name -> poker.Player
-	22, // 16: poker.Player.money:type_name -> poker.PlayerMoney
-	2,  // 17: poker.Player.state:type_name -> poker.PlayerState
-	24, // 18: poker.Player.card:type_name -> poker.Card
-	24, // 19: poker.Player.hand:type_name -> poker.Card
-	21, // 20: poker.Player.lastAction:type_name -> poker.LastAction
-	0,  // 21: poker.LastAction.action:type_name -> poker.PlayerAction
-	24, // 22: poker.CommunityCards.card:type_name -> poker.Card
-	3,  // 23: poker.Card.suite:type_name -> poker.CardSuit
-	4,  // 24: poker.Card.rank:type_name -> poker.CardRank
-	5,  // 25: poker.PokerServer.AckToken:input_type -> poker.AckTokenRequest
-	10, // 26: poker.PokerServer.JoinTable:input_type -> poker.JoinTableRequest
-	15, // 27: poker.PokerServer.Play:input_type -> poker.PlayRequest
-	8,  // 28: poker.PokerServer.Register:input_type -> poker.RegisterRequest
-	12, // 29: poker.PokerServer.TakeTurn:input_type -> poker.TakeTurnRequest
-	6,  // 30: poker.PokerServer.AckToken:output_type -> poker.AckTokenResponse
-	11, // 31: poker.PokerServer.JoinTable:output_type -> poker.JoinTableResponse
-	19, // 32: poker.PokerServer.Play:output_type -> poker.GameData
-	9,  // 33: poker.PokerServer.Register:output_type -> poker.RegisterResponse
-	13, // 34: poker.PokerServer.TakeTurn:output_type -> poker.TakeTurnResponse
-	30, // [30:35] is the sub-list for method output_type
-	25, // [25:30] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	33, // 0: poker.AckTokenRequest.clientInfo:type_name -> poker.ClientInfo
+	12, // 1: poker.ActionOpts.autoRebuy:type_name -> poker.AutoRebuy
+	1,  // 2: poker.ActionOpts.preAction:type_name -> poker.PreAction
+	33, // 3: poker.RegisterRequest.clientInfo:type_name -> poker.ClientInfo
+	0,  // 4: poker.RegisterRequest.playerAction:type_name -> poker.PlayerAction
+	33, // 5: poker.JoinTableRequest.clientInfo:type_name -> poker.ClientInfo
+	0,  // 6: poker.JoinTableRequest.playerAction:type_name -> poker.PlayerAction
+	12, // 7: poker.JoinTableRequest.autoRebuy:type_name -> poker.AutoRebuy
+	33, // 8: poker.TakeTurnRequest.clientInfo:type_name -> poker.ClientInfo
+	0,  // 9: poker.TakeTurnRequest.playerAction:type_name -> poker.PlayerAction
+	11, // 10: poker.TakeTurnRequest.actionOpts:type_name -> poker.ActionOpts
+	3,  // 11: poker.TableConfig.variant:type_name -> poker.GameVariant
+	4,  // 12: poker.TableConfig.bettingStructure:type_name -> poker.BettingStructure
+	33, // 13: poker.UpdateInvitesRequest.clientInfo:type_name -> poker.ClientInfo
+	33, // 14: poker.CreateTableRequest.clientInfo:type_name -> poker.ClientInfo
+	20, // 15: poker.CreateTableRequest.config:type_name -> poker.TableConfig
+	3,  // 16: poker.TableFilter.variants:type_name -> poker.GameVariant
+	4,  // 17: poker.TableFilter.bettingStructures:type_name -> poker.BettingStructure
+	33, // 18: poker.ListTablesRequest.clientInfo:type_name -> poker.ClientInfo
+	25, // 19: poker.ListTablesRequest.filter:type_name -> poker.TableFilter
+	28, // 20: poker.ListTablesResponse.tables:type_name -> poker.TableSummary
+	3,  // 21: poker.TableSummary.variant:type_name -> poker.GameVariant
+	4,  // 22: poker.TableSummary.bettingStructure:type_name -> poker.BettingStructure
+	2,  // 23: poker.TableSummary.gameState:type_name -> poker.GameState
+	33, // 24: poker.JoinWaitlistRequest.clientInfo:type_name -> poker.ClientInfo
+	25, // 25: poker.JoinWaitlistRequest.filter:type_name -> poker.TableFilter
+	16, // 26: poker.WaitlistUpdate.seated:type_name -> poker.JoinTableResponse
+	33, // 27: poker.WatchRequest.clientInfo:type_name -> poker.ClientInfo
+	33, // 28: poker.PlayRequest.clientInfo:type_name -> poker.ClientInfo
+	0,  // 29: poker.PlayRequest.playerAction:type_name -> poker.PlayerAction
+	3,  // 30: poker.GameInfo.variant:type_name -> poker.GameVariant
+	4,  // 31: poker.GameInfo.bettingStructure:type_name -> poker.BettingStructure
+	2,  // 32: poker.GameInfo.gameState:type_name -> poker.GameState
+	43, // 33: poker.GameInfo.CommunityCards:type_name -> poker.CommunityCards
+	40, // 34: poker.GameInfo.players:type_name -> poker.Player
+	37, // 35: poker.GameInfo.winning_ids:type_name -> poker.Winners
+	35, // 36: poker.GameInfo.tournament:type_name -> poker.TournamentInfo
+	36, // 37: poker.TournamentInfo.results:type_name -> poker.TournamentFinish
+	34, // 38: poker.GameData.info:type_name -> poker.GameInfo
+	0,  // 39: poker.GameData.allowedActions:type_name -> poker.PlayerAction
+	1,  // 40: poker.GameData.preAction:type_name -> poker.PreAction
+	39, // 41: poker.GameData.events:type_name -> poker.TableEvent
+	40, // 42: poker.GameData.player:type_name -> poker.Player
+	5,  // 43: poker.TableEvent.type:type_name -> poker.TableEventType
+	0,  // 44: poker.TableEvent.action:type_name -> poker.PlayerAction
+	44, // 45: poker.TableEvent.cards:type_name -> poker.Card
+	2,  // 46: poker.TableEvent.gameState:type_name -> poker.GameState
+	42, // 47: poker.Player.money:type_name -> poker.PlayerMoney
+	6,  // 48: poker.Player.state:type_name -> poker.PlayerState
+	44, // 49: poker.Player.card:type_name -> poker.Card
+	44, // 50: poker.Player.hand:type_name -> poker.Card
+	41, // 51: poker.Player.lastAction:type_name -> poker.LastAction
+	0,  // 52: poker.LastAction.action:type_name -> poker.PlayerAction
+	44, // 53: poker.CommunityCards.card:type_name -> poker.Card
+	7,  // 54: poker.Card.suite:type_name -> poker.CardSuit
+	8,  // 55: poker.Card.rank:type_name -> poker.CardRank
+	9,  // 56: poker.PokerServer.AckToken:input_type -> poker.AckTokenRequest
+	15, // 57: poker.PokerServer.JoinTable:input_type -> poker.JoinTableRequest
+	23, // 58: poker.PokerServer.CreateTable:input_type -> poker.CreateTableRequest
+	21, // 59: poker.PokerServer.UpdateInvites:input_type -> poker.UpdateInvitesRequest
+	26, // 60: poker.PokerServer.ListTables:input_type -> poker.ListTablesRequest
+	26, // 61: poker.PokerServer.WatchLobby:input_type -> poker.ListTablesRequest
+	32, // 62: poker.PokerServer.Play:input_type -> poker.PlayRequest
+	13, // 63: poker.PokerServer.Register:input_type -> poker.RegisterRequest
+	17, // 64: poker.PokerServer.TakeTurn:input_type -> poker.TakeTurnRequest
+	31, // 65: poker.PokerServer.Watch:input_type -> poker.WatchRequest
+	29, // 66: poker.PokerServer.JoinWaitlist:input_type -> poker.JoinWaitlistRequest
+	10, // 67: poker.PokerServer.AckToken:output_type -> poker.AckTokenResponse
+	16, // 68: poker.PokerServer.JoinTable:output_type -> poker.JoinTableResponse
+	24, // 69: poker.PokerServer.CreateTable:output_type -> poker.CreateTableResponse
+	22, // 70: poker.PokerServer.UpdateInvites:output_type -> poker.UpdateInvitesResponse
+	27, // 71: poker.PokerServer.ListTables:output_type -> poker.ListTablesResponse
+	27, // 72: poker.PokerServer.WatchLobby:output_type -> poker.ListTablesResponse
+	38, // 73: poker.PokerServer.Play:output_type -> poker.GameData
+	14, // 74: poker.PokerServer.Register:output_type -> poker.RegisterResponse
+	18, // 75: poker.PokerServer.TakeTurn:output_type -> poker.TakeTurnResponse
+	34, // 76: poker.PokerServer.Watch:output_type -> poker.GameInfo
+	30, // 77: poker.PokerServer.JoinWaitlist:output_type -> poker.WaitlistUpdate
+	67, // [67:78] is the sub-list for method output_type
+	56, // [56:67] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_poker_proto_init() }
@@ -2122,8 +4573,164 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckTokenResponse); i {
+		file_poker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionOpts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoRebuy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TakeTurnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TakeTurnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInvitesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2134,8 +4741,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionOpts); i {
+		file_poker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2146,8 +4753,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_poker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2158,8 +4765,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_poker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2170,8 +4777,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinTableRequest); i {
+		file_poker_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2182,8 +4789,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinTableResponse); i {
+		file_poker_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2194,8 +4801,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TakeTurnRequest); i {
+		file_poker_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinWaitlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2206,8 +4813,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TakeTurnResponse); i {
+		file_poker_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2218,8 +4825,8 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisconnectResponse); i {
+		file_poker_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2230,7 +4837,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlayRequest); i {
 			case 0:
 				return &v.state
@@ -2242,7 +4849,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientInfo); i {
 			case 0:
 				return &v.state
@@ -2254,7 +4861,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameInfo); i {
 			case 0:
 				return &v.state
@@ -2266,7 +4873,31 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentFinish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Winners); i {
 			case 0:
 				return &v.state
@@ -2278,7 +4909,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameData); i {
 			case 0:
 				return &v.state
@@ -2290,7 +4921,19 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_poker_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Player); i {
 			case 0:
 				return &v.state
@@ -2302,7 +4945,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LastAction); i {
 			case 0:
 				return &v.state
@@ -2314,7 +4957,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlayerMoney); i {
 			case 0:
 				return &v.state
@@ -2326,7 +4969,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommunityCards); i {
 			case 0:
 				return &v.state
@@ -2338,7 +4981,7 @@ func file_poker_proto_init() {
 				return nil
 			}
 		}
-		file_poker_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_poker_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Card); i {
 			case 0:
 				return &v.state
@@ -2351,13 +4994,14 @@ func file_poker_proto_init() {
 			}
 		}
 	}
+	file_poker_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_poker_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   20,
+			NumEnums:      9,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2388,12 +5032,26 @@ type PokerServerClient interface {
 	AckToken(ctx context.Context, in *AckTokenRequest, opts ...grpc.CallOption) (*AckTokenResponse, error)
 	// JoinTable joins a table once authenticated
 	JoinTable(ctx context.Context, in *JoinTableRequest, opts ...grpc.CallOption) (*JoinTableResponse, error)
+	// CreateTable creates and starts a new cash table
+	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
+	// UpdateInvites adds and revokes invites to a private table, only the table
+	// owner can do this
+	UpdateInvites(ctx context.Context, in *UpdateInvitesRequest, opts ...grpc.CallOption) (*UpdateInvitesResponse, error)
+	// ListTables lists the tables matching the filter
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	// WatchLobby streams the list of tables matching the filter whenever it changes
+	WatchLobby(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (PokerServer_WatchLobbyClient, error)
 	// Play subscribes the client to updates after joining a table
 	Play(ctx context.Context, in *PlayRequest, opts ...grpc.CallOption) (PokerServer_PlayClient, error)
 	// Register registers with the server
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	// TakeTurn sends a Poker game turn request
 	TakeTurn(ctx context.Context, in *TakeTurnRequest, opts ...grpc.CallOption) (*TakeTurnResponse, error)
+	// Watch streams the public state of a table without taking a seat
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PokerServer_WatchClient, error)
+	// JoinWaitlist queues for a seat at a full table, or at any table matching the filter
+	// The stream sends the position in the queue, and the seat offer to ack with AckToken.
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (PokerServer_JoinWaitlistClient, error)
 }
 
 type pokerServerClient struct {
@@ -2422,8 +5080,67 @@ func (c *pokerServerClient) JoinTable(ctx context.Context, in *JoinTableRequest,
 	return out, nil
 }
 
+func (c *pokerServerClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error) {
+	out := new(CreateTableResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerServer/CreateTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServerClient) UpdateInvites(ctx context.Context, in *UpdateInvitesRequest, opts ...grpc.CallOption) (*UpdateInvitesResponse, error) {
+	out := new(UpdateInvitesResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerServer/UpdateInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServerClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerServer/ListTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServerClient) WatchLobby(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (PokerServer_WatchLobbyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PokerServer_serviceDesc.Streams[0], "/poker.PokerServer/WatchLobby", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerServerWatchLobbyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PokerServer_WatchLobbyClient interface {
+	Recv() (*ListTablesResponse, error)
+	grpc.ClientStream
+}
+
+type pokerServerWatchLobbyClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerServerWatchLobbyClient) Recv() (*ListTablesResponse, error) {
+	m := new(ListTablesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *pokerServerClient) Play(ctx context.Context, in *PlayRequest, opts ...grpc.CallOption) (PokerServer_PlayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PokerServer_serviceDesc.Streams[0], "/poker.PokerServer/Play", opts...)
+	stream, err := c.cc.NewStream(ctx, &_PokerServer_serviceDesc.Streams[1], "/poker.PokerServer/Play", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2472,18 +5189,96 @@ func (c *pokerServerClient) TakeTurn(ctx context.Context, in *TakeTurnRequest, o
 	return out, nil
 }
 
+func (c *pokerServerClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PokerServer_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PokerServer_serviceDesc.Streams[2], "/poker.PokerServer/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerServerWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PokerServer_WatchClient interface {
+	Recv() (*GameInfo, error)
+	grpc.ClientStream
+}
+
+type pokerServerWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerServerWatchClient) Recv() (*GameInfo, error) {
+	m := new(GameInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pokerServerClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (PokerServer_JoinWaitlistClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PokerServer_serviceDesc.Streams[3], "/poker.PokerServer/JoinWaitlist", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerServerJoinWaitlistClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PokerServer_JoinWaitlistClient interface {
+	Recv() (*WaitlistUpdate, error)
+	grpc.ClientStream
+}
+
+type pokerServerJoinWaitlistClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerServerJoinWaitlistClient) Recv() (*WaitlistUpdate, error) {
+	m := new(WaitlistUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PokerServerServer is the server API for PokerServer service.
 type PokerServerServer interface {
 	// AckToken acks an ack token
 	AckToken(context.Context, *AckTokenRequest) (*AckTokenResponse, error)
 	// JoinTable joins a table once authenticated
 	JoinTable(context.Context, *JoinTableRequest) (*JoinTableResponse, error)
+	// CreateTable creates and starts a new cash table
+	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
+	// UpdateInvites adds and revokes invites to a private table, only the table
+	// owner can do this
+	UpdateInvites(context.Context, *UpdateInvitesRequest) (*UpdateInvitesResponse, error)
+	// ListTables lists the tables matching the filter
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	// WatchLobby streams the list of tables matching the filter whenever it changes
+	WatchLobby(*ListTablesRequest, PokerServer_WatchLobbyServer) error
 	// Play subscribes the client to updates after joining a table
 	Play(*PlayRequest, PokerServer_PlayServer) error
 	// Register registers with the server
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	// TakeTurn sends a Poker game turn request
 	TakeTurn(context.Context, *TakeTurnRequest) (*TakeTurnResponse, error)
+	// Watch streams the public state of a table without taking a seat
+	Watch(*WatchRequest, PokerServer_WatchServer) error
+	// JoinWaitlist queues for a seat at a full table, or at any table matching the filter
+	// The stream sends the position in the queue, and the seat offer to ack with AckToken.
+	JoinWaitlist(*JoinWaitlistRequest, PokerServer_JoinWaitlistServer) error
 }
 
 // UnimplementedPokerServerServer can be embedded to have forward compatible implementations.
@@ -2496,6 +5291,18 @@ func (*UnimplementedPokerServerServer) AckToken(context.Context, *AckTokenReques
 func (*UnimplementedPokerServerServer) JoinTable(context.Context, *JoinTableRequest) (*JoinTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinTable not implemented")
 }
+func (*UnimplementedPokerServerServer) CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTable not implemented")
+}
+func (*UnimplementedPokerServerServer) UpdateInvites(context.Context, *UpdateInvitesRequest) (*UpdateInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvites not implemented")
+}
+func (*UnimplementedPokerServerServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (*UnimplementedPokerServerServer) WatchLobby(*ListTablesRequest, PokerServer_WatchLobbyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLobby not implemented")
+}
 func (*UnimplementedPokerServerServer) Play(*PlayRequest, PokerServer_PlayServer) error {
 	return status.Errorf(codes.Unimplemented, "method Play not implemented")
 }
@@ -2505,6 +5312,12 @@ func (*UnimplementedPokerServerServer) Register(context.Context, *RegisterReques
 func (*UnimplementedPokerServerServer) TakeTurn(context.Context, *TakeTurnRequest) (*TakeTurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TakeTurn not implemented")
 }
+func (*UnimplementedPokerServerServer) Watch(*WatchRequest, PokerServer_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedPokerServerServer) JoinWaitlist(*JoinWaitlistRequest, PokerServer_JoinWaitlistServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
 
 func RegisterPokerServerServer(s *grpc.Server, srv PokerServerServer) {
 	s.RegisterService(&_PokerServer_serviceDesc, srv)
@@ -2546,6 +5359,81 @@ func _PokerServer_JoinTable_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokerServer_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServerServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerServer/CreateTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServerServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerServer_UpdateInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServerServer).UpdateInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerServer/UpdateInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServerServer).UpdateInvites(ctx, req.(*UpdateInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerServer_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServerServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerServer/ListTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServerServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerServer_WatchLobby_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTablesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServerServer).WatchLobby(m, &pokerServerWatchLobbyServer{stream})
+}
+
+type PokerServer_WatchLobbyServer interface {
+	Send(*ListTablesResponse) error
+	grpc.ServerStream
+}
+
+type pokerServerWatchLobbyServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerServerWatchLobbyServer) Send(m *ListTablesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PokerServer_Play_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlayRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2603,6 +5491,48 @@ func _PokerServer_TakeTurn_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokerServer_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServerServer).Watch(m, &pokerServerWatchServer{stream})
+}
+
+type PokerServer_WatchServer interface {
+	Send(*GameInfo) error
+	grpc.ServerStream
+}
+
+type pokerServerWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerServerWatchServer) Send(m *GameInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PokerServer_JoinWaitlist_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinWaitlistRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServerServer).JoinWaitlist(m, &pokerServerJoinWaitlistServer{stream})
+}
+
+type PokerServer_JoinWaitlistServer interface {
+	Send(*WaitlistUpdate) error
+	grpc.ServerStream
+}
+
+type pokerServerJoinWaitlistServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerServerJoinWaitlistServer) Send(m *WaitlistUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _PokerServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "poker.PokerServer",
 	HandlerType: (*PokerServerServer)(nil),
@@ -2615,6 +5545,18 @@ var _PokerServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "JoinTable",
 			Handler:    _PokerServer_JoinTable_Handler,
 		},
+		{
+			MethodName: "CreateTable",
+			Handler:    _PokerServer_CreateTable_Handler,
+		},
+		{
+			MethodName: "UpdateInvites",
+			Handler:    _PokerServer_UpdateInvites_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _PokerServer_ListTables_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _PokerServer_Register_Handler,
@@ -2625,11 +5567,26 @@ var _PokerServer_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLobby",
+			Handler:       _PokerServer_WatchLobby_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Play",
 			Handler:       _PokerServer_Play_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Watch",
+			Handler:       _PokerServer_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JoinWaitlist",
+			Handler:       _PokerServer_JoinWaitlist_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "poker.proto",
 }
@@ -0,0 +1,71 @@
+// package main ...
+// Replays the hands of a JSON hand history written by the server, and reports any hand that does not play out as
+// recorded. Hands are dealt again from their recorded deck seed, so a hand reported from a running server can be
+// reproduced from its hand number.
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/DanTulovsky/logger"
+	"github.com/fatih/color"
+
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+)
+
+var (
+	handHistory = flag.String("hand_history", "", "JSON hand history to replay, a <table>-<id>.json file in the server's -hand_history_dir")
+	hand        = flag.Int64("hand", 0, "if set, only the hand with this number is replayed")
+	show        = flag.Bool("show", false, "if true, the replayed hands are printed in the PokerStars format")
+)
+
+func main() {
+	flag.Parse()
+	logg := logger.New("replay", color.New(color.FgCyan))
+
+	if *handHistory == "" {
+		logg.Fatal("-hand_history is required")
+	}
+
+	f, err := os.Open(*handHistory)
+	if err != nil {
+		logg.Fatal(err)
+	}
+	hands, err := handhistory.ReadJSON(f)
+	f.Close()
+	if err != nil {
+		logg.Fatal(err)
+	}
+
+	var replayed, failed int
+	for _, h := range hands {
+		if *hand != 0 && h.Number != *hand {
+			continue
+		}
+		replayed++
+
+		r, err := table.Replay(h, table.DefaultTableConfig())
+		if err != nil {
+			failed++
+			logg.Error(err)
+			continue
+		}
+		logg.Infof("hand %d (seed %d) replayed as recorded", h.Number, h.Seed)
+
+		if *show {
+			if err := handhistory.WritePokerStars(os.Stdout, r, ""); err != nil {
+				logg.Fatal(err)
+			}
+		}
+	}
+
+	switch {
+	case replayed == 0 && *hand != 0:
+		logg.Fatalf("hand %d is not in %v", *hand, *handHistory)
+	case failed > 0:
+		logg.Fatalf("%d of %d hands did not replay as recorded", failed, replayed)
+	}
+	logg.Infof("%d hands replayed as recorded", replayed)
+}
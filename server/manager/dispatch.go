@@ -0,0 +1,345 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+var (
+	tableQueueSize = flag.Int("manager_table_queue", 64, "number of requests queued for a table before new ones are rejected")
+	tableTimeout   = flag.Duration("table_request_timeout", time.Second*5, "time to wait for a table to answer a request without a deadline")
+)
+
+// tableQueue runs the requests for one table in order on its own goroutine, so a slow table only delays its own players
+type tableQueue struct {
+	t    *table.Table
+	jobs chan func()
+
+	// closed is set once run stops taking jobs, nothing else is queued after it
+	mu     sync.Mutex
+	closed bool
+}
+
+// newTableQueue returns a new queue for the table, run must be started for it
+func newTableQueue(t *table.Table) *tableQueue {
+	return &tableQueue{
+		t:    t,
+		jobs: make(chan func(), *tableQueueSize),
+	}
+}
+
+// run runs the queued requests until the table stops
+func (q *tableQueue) run() {
+	for {
+		select {
+		case job := <-q.jobs:
+			job()
+		case <-q.t.Done():
+			q.mu.Lock()
+			q.closed = true
+			q.mu.Unlock()
+
+			// the requests still queued fail right away, the table no longer answers
+			for {
+				select {
+				case job := <-q.jobs:
+					job()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// submit queues the job, it returns an error if the table is too far behind or closed
+func (q *tableQueue) submit(job func()) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	select {
+	case <-q.t.Done():
+		return fmt.Errorf("table [%v] is closed", q.t.Name)
+	default:
+	}
+	if q.closed {
+		return fmt.Errorf("table [%v] is closed", q.t.Name)
+	}
+
+	select {
+	case q.jobs <- job:
+		return nil
+	default:
+		return fmt.Errorf("table [%v] is busy, try again", q.t.Name)
+	}
+}
+
+// dispatch routes the request to the queue of the table it is for, or handles it on its own goroutine
+// The manager loop never waits on a table.
+func (m *Manager) dispatch(in actions.PlayerAction) {
+	m.l.Infof("[%v] Received request from player: %#v", in.ClientInfo.PlayerUsername, in.Action.String())
+
+	if !m.userStore.Check(in.ClientInfo.PlayerUsername) {
+		m.replyError(in, fmt.Errorf("invalid username or password"))
+		return
+	}
+
+	if err := m.route(in); err != nil {
+		m.replyError(in, err)
+	}
+}
+
+// route looks up the player and table of the request and starts handling it
+func (m *Manager) route(in actions.PlayerAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var p *player.Player
+	var t *table.Table
+	var err error
+
+	if playerID := id.PlayerID(in.ClientInfo.PlayerID); playerID != "" {
+		if p, err = m.playerByID(playerID); err != nil {
+			return err
+		}
+	}
+	if tableID := id.TableID(in.ClientInfo.TableID); tableID != "" {
+		if t, err = m.tableByID(tableID); err != nil {
+			return err
+		}
+	}
+
+	a, opts, ok := tableActionFor(in)
+	if in.Action == ppb.PlayerAction_PlayerActionAckToken && m.hasSeatOffer(p, in.Opts.GetAckToken()) {
+		ok = false
+	}
+	if !ok {
+		go m.handle(in, p, t)
+		return nil
+	}
+
+	switch in.Action {
+	case ppb.PlayerAction_PlayerActionPlay, ppb.PlayerAction_PlayerActionDisconnect, ppb.PlayerAction_PlayerActionSnapshot:
+		if p != nil {
+			// a reconnecting client may not know yet that it was moved to another table
+			t = m.currentTable(p, t)
+		}
+	}
+
+	switch {
+	case p == nil:
+		return fmt.Errorf("playerID is required for %v", in.Action)
+	case t == nil:
+		return fmt.Errorf("tableID is required for %v", in.Action)
+	}
+	return m.queues[t.ID].submit(func() {
+		m.reply(in, m.handleTableAction(in, p, t, a, opts))
+	})
+}
+
+// tableActionFor returns the table action for requests that only need the table the player is at
+func tableActionFor(in actions.PlayerAction) (actions.TableAction, interface{}, bool) {
+	switch in.Action {
+	case ppb.PlayerAction_PlayerActionPlay:
+		return actions.ActionRegisterPlayerCC, in.ToClientChan, true
+	case ppb.PlayerAction_PlayerActionDisconnect:
+		return actions.ActionDisconnect, in.ToClientChan, true
+	case ppb.PlayerAction_PlayerActionAckToken:
+		return actions.ActionAckToken, in.Opts.GetAckToken(), true
+	case ppb.PlayerAction_PlayerActionBuyIn:
+		return actions.ActionBuyIn, in.Opts.GetBuyinAmount(), true
+	case ppb.PlayerAction_PlayerActionAutoRebuy:
+		return actions.ActionAutoRebuy, in.Opts.GetAutoRebuy(), true
+	case ppb.PlayerAction_PlayerActionLeaveTable:
+		return actions.ActionLeaveTable, nil, true
+	case ppb.PlayerAction_PlayerActionSitOut:
+		return actions.ActionSitOut, int(in.Opts.GetSitOutHands()), true
+	case ppb.PlayerAction_PlayerActionSitIn:
+		return actions.ActionSitIn, nil, true
+	case ppb.PlayerAction_PlayerActionChangeSeat:
+		// seats are numbered from 1 in the API
+		return actions.ActionChangeSeat, int(in.Opts.GetSeat()) - 1, true
+	case ppb.PlayerAction_PlayerActionSnapshot:
+		return actions.ActionSnapshot, nil, true
+	case ppb.PlayerAction_PlayerActionPreAction:
+		return actions.ActionPreAction, in.Opts.GetPreAction(), true
+	case ppb.PlayerAction_PlayerActionStraddle:
+		return actions.ActionStraddle, in.Opts.GetStraddle(), true
+	case ppb.PlayerAction_PlayerActionCheck:
+		return actions.ActionCheck, nil, true
+	case ppb.PlayerAction_PlayerActionFold:
+		return actions.ActionFold, nil, true
+	case ppb.PlayerAction_PlayerActionCall:
+		return actions.ActionCall, nil, true
+	case ppb.PlayerAction_PlayerActionAllIn:
+		return actions.ActionAllIn, nil, true
+	case ppb.PlayerAction_PlayerActionBet:
+		return actions.ActionBet, in.Opts.GetBetAmount(), true
+	}
+	return actions.ActionInfo, nil, false
+}
+
+// handleTableAction sends the request to the table, it runs on the table queue
+func (m *Manager) handleTableAction(in actions.PlayerAction, p *player.Player, t *table.Table, a actions.TableAction, opts interface{}) actions.PlayerActionResult {
+	ctx, cancel := requestContext(in.Ctx)
+	defer cancel()
+
+	if a == actions.ActionDisconnect {
+		if err := m.disconnectPlayer(ctx, p, t, in.ToClientChan); err != nil {
+			return actions.NewPlayerActionError(err)
+		}
+		return actions.NewPlayerActionResult(nil, &ppb.DisconnectResponse{})
+	}
+
+	if res := m.tableRequest(ctx, t, a, p, opts); res.Err != nil {
+		return actions.NewPlayerActionError(res.Err)
+	}
+
+	switch in.Action {
+	case ppb.PlayerAction_PlayerActionPlay:
+		return actions.NewPlayerActionResult(nil, &ppb.JoinTableResponse{})
+	case ppb.PlayerAction_PlayerActionAckToken:
+		return actions.NewPlayerActionResult(nil, &ppb.AckTokenResponse{})
+	}
+	return actions.NewPlayerActionResult(nil, &ppb.TakeTurnResponse{})
+}
+
+// handle handles the requests that are not for a single table, on their own goroutine
+func (m *Manager) handle(in actions.PlayerAction, p *player.Player, t *table.Table) {
+	ctx, cancel := requestContext(in.Ctx)
+	defer cancel()
+
+	var err error
+
+	switch in.Action {
+	case ppb.PlayerAction_PlayerActionRegister:
+		if p, err = m.addPlayer(in); err != nil {
+			if errors.Is(err, actions.ErrUserExists) {
+				m.l.Infof("[%v] already registered...", p.Name)
+				// user already registered
+				m.reply(in, actions.NewPlayerActionResult(nil, &ppb.RegisterResponse{
+					PlayerID: p.ID.String(),
+				}))
+				return
+			}
+			// actual error
+			m.replyError(in, err)
+			return
+		}
+		// new regisration
+		m.reply(in, actions.NewPlayerActionResult(nil, &ppb.RegisterResponse{
+			PlayerID: p.ID.String(),
+		}))
+
+	case ppb.PlayerAction_PlayerActionJoinTable:
+		var tableID id.TableID
+		var pos int
+		if tableID, pos, err = m.joinTable(ctx, p, t, in.Opts); err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, &ppb.JoinTableResponse{
+			TableID:  tableID.String(),
+			Position: int64(pos),
+		}))
+
+	case ppb.PlayerAction_PlayerActionAckToken:
+		var offer bool
+		if offer, err = m.takeOfferedSeat(ctx, p, in.Opts.GetAckToken()); !offer {
+			// the offer expired since the request was dispatched
+			err = fmt.Errorf("the seat offer expired")
+		}
+		if err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, &ppb.AckTokenResponse{}))
+
+	case ppb.PlayerAction_PlayerActionCreateTable:
+		if t, err = m.createPlayerTable(in.ClientInfo.PlayerUsername, in.TableConfig); err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, &ppb.CreateTableResponse{
+			TableID:   t.ID.String(),
+			TableName: t.Name,
+		}))
+
+	case ppb.PlayerAction_PlayerActionUpdateInvites:
+		if t == nil {
+			m.replyError(in, fmt.Errorf("tableID is required to update invites"))
+			return
+		}
+		var invited []string
+		if invited, err = t.UpdateInvites(in.ClientInfo.PlayerUsername, in.Invite, in.Revoke); err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, &ppb.UpdateInvitesResponse{Invited: invited}))
+
+	case ppb.PlayerAction_PlayerActionJoinWaitlist:
+		if err = m.joinWaitlist(p, t, in.TableFilter, in.Opts, in.ToWaitlistChan, in.Ctx.Done()); err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, nil))
+
+	case ppb.PlayerAction_PlayerActionListTables:
+		m.reply(in, actions.NewPlayerActionResult(nil, m.listTables(in.TableFilter)))
+
+	case ppb.PlayerAction_PlayerActionWatch:
+		if err = m.watchTable(ctx, t, in.ClientInfo.PlayerUsername, in.Opts.GetTablePassword(), in.ToWatcherChan, in.WatchDelay, in.Ctx.Done()); err != nil {
+			m.replyError(in, err)
+			return
+		}
+		m.reply(in, actions.NewPlayerActionResult(nil, nil))
+
+	default:
+		m.replyError(in, fmt.Errorf("unsupported action: %v", in.Action))
+	}
+}
+
+// reply sends the result back to the grpc server, unless it stopped waiting for it
+func (m *Manager) reply(in actions.PlayerAction, res actions.PlayerActionResult) {
+	if res.Err != nil {
+		m.l.Errorf("[%v] %v: %v", in.ClientInfo.PlayerUsername, in.Action, res.Err)
+	}
+
+	var done <-chan struct{}
+	if in.Ctx != nil {
+		done = in.Ctx.Done()
+	}
+
+	select {
+	case in.ResultC <- res:
+	case <-done:
+	}
+}
+
+// replyError sends the error back to the grpc server
+func (m *Manager) replyError(in actions.PlayerAction, err error) {
+	m.reply(in, actions.NewPlayerActionError(err))
+}
+
+// requestContext returns the context the tables are waited on with for the request
+// It is the deadline of the rpc, if it has one.
+func requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, *tableTimeout)
+}
@@ -0,0 +1,73 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+)
+
+func TestTableRequest(t *testing.T) {
+	m := New()
+	// never run, the table does not answer
+	stalled := table.New(make(chan table.ActionRequest), table.DefaultTableConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	if res := m.tableRequest(ctx, stalled, actions.ActionInfo, nil, nil); res.Err == nil {
+		t.Errorf("tableRequest() past the deadline = nil, want error")
+	}
+
+	stalled.Stop()
+	if res := m.tableRequest(context.Background(), stalled, actions.ActionInfo, nil, nil); res.Err == nil {
+		t.Errorf("tableRequest() to a stopped table = nil, want error")
+	}
+}
+
+func TestTableQueue(t *testing.T) {
+	tbl := table.New(make(chan table.ActionRequest), table.DefaultTableConfig())
+	q := newTableQueue(tbl)
+
+	ran := make(chan int, *tableQueueSize)
+	for i := 0; i < *tableQueueSize; i++ {
+		i := i
+		if err := q.submit(func() { ran <- i }); err != nil {
+			t.Fatalf("submit() = %v", err)
+		}
+	}
+	if err := q.submit(func() {}); err == nil {
+		t.Errorf("submit() to a full queue = nil, want error")
+	}
+
+	// the queued requests still run once the table stops
+	tbl.Stop()
+	q.run()
+
+	for i := 0; i < *tableQueueSize; i++ {
+		if got := <-ran; got != i {
+			t.Fatalf("job %d ran in place %d", got, i)
+		}
+	}
+}
+
+func TestTableQueue_halted(t *testing.T) {
+	tbl := table.New(make(chan table.ActionRequest), table.DefaultTableConfig())
+	q := newTableQueue(tbl)
+
+	// a halted table is still routed to until the manager removes it
+	tbl.Stop()
+	if err := q.submit(func() { t.Errorf("job queued for a halted table ran") }); err == nil {
+		t.Errorf("submit() to a halted table = nil, want error")
+	}
+
+	// nothing is left in the queue once run returns
+	q.run()
+	if err := q.submit(func() { t.Errorf("job queued after run returned ran") }); err == nil {
+		t.Errorf("submit() after run returned = nil, want error")
+	}
+	if len(q.jobs) != 0 {
+		t.Errorf("%d jobs queued with nothing to run them", len(q.jobs))
+	}
+}
@@ -2,10 +2,11 @@ package manager
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/opentracing/opentracing-go/log"
@@ -17,8 +18,9 @@ import (
 
 	"github.com/DanTulovsky/logger"
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
 	"github.com/DanTulovsky/pepper-poker-v2/id"
-	"github.com/DanTulovsky/pepper-poker-v2/proto"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 	"github.com/DanTulovsky/pepper-poker-v2/server/server"
 	"github.com/DanTulovsky/pepper-poker-v2/server/table"
@@ -31,8 +33,23 @@ import (
 )
 
 var (
-	tickDelay = flag.Duration("manager_tick_delay", time.Millisecond*10, "delay between manager ticks")
-	numTables = 1
+	tickDelay   = flag.Duration("manager_tick_delay", time.Millisecond*100, "delay between manager housekeeping passes")
+	gameVariant = flag.String("game_variant", ppb.GameVariant_GameVariantTexasHoldem.String(), "poker variant played at the tables")
+	betting     = flag.String("betting_structure", "", "betting structure used at the tables; if empty, the usual structure for the variant")
+	numTables   = flag.Int("num_tables", 1, "number of cash tables created at startup, players can create more")
+
+	tableIdleTimeout = flag.Duration("table_idle_timeout", time.Minute*10, "tables created by players are closed once they have been empty this long")
+	tableCreators    = flag.String("table_creators", "", "comma separated usernames allowed to create tables; if empty, any user can")
+	maxTables        = flag.Int("max_tables", 20, "maximum number of tables")
+
+	userDB = flag.String("user_db", "users.db", "file the users and their bank balances are kept in; if empty, users are kept in memory and bank balances are lost on restart")
+
+	sitngo           = flag.Bool("sitngo", false, "run sit-and-go tournament tables instead of cash tables")
+	sngEntrants      = flag.Int("sng_entrants", table.DefaultTournamentConfig().Entrants, "number of players in a sit-and-go")
+	sngBuyin         = flag.Int64("sng_buyin", table.DefaultTournamentConfig().BuyIn, "sit-and-go buyin, paid from the bank")
+	sngStartingChips = flag.Int64("sng_starting_chips", table.DefaultTournamentConfig().StartingChips, "sit-and-go starting chips")
+	sngLevelDuration = flag.Duration("sng_level_duration", table.DefaultTournamentConfig().LevelDuration, "time after which the sit-and-go blinds go up; 0 to disable")
+	sngLevelHands    = flag.Int("sng_level_hands", 0, "number of hands after which the sit-and-go blinds go up; 0 to disable")
 )
 
 const (
@@ -46,15 +63,39 @@ type Manager struct {
 	l                  *logger.Logger
 	fromGrpcServerChan chan actions.PlayerAction
 
+	// times the manager, and the tables it creates
+	clock clock.Clock
+
+	// guards the tables, players and waitlist below, it is never held while waiting on a table
+	mu sync.Mutex
+
 	tables map[id.TableID]*table.Table
+	// the requests for each table, run in order
+	queues map[id.TableID]*tableQueue
 	// Todo: Consider either adding locks on *Player or just uding IDs here
 	// The Table accesses and calls methods on Player
 	players map[id.PlayerID]*player.Player
 
-	// Map is username to external user object
-	users map[string]users.User
+	// users and their bank balances
+	userStore users.UserStore
 
 	defaultPlayerBank int64
+
+	// variant and betting structure used for new tables
+	variant          ppb.GameVariant
+	bettingStructure ppb.BettingStructure
+
+	// the multi-table tournament played at all tables, if any
+	tournament *table.Tournament
+	// the table each player is seated at, players move between tournament tables
+	playerTables map[id.PlayerID]id.TableID
+	lastBalance  time.Time
+
+	// time each table with an idle timeout became empty
+	idleSince map[id.TableID]time.Time
+
+	// players queued for a seat, in order
+	waitlist []*waitlistEntry
 }
 
 // New returns a new manager
@@ -65,8 +106,12 @@ func New() *Manager {
 	return &Manager{
 		l:                  logger.New("manager", color.New(color.FgRed)),
 		fromGrpcServerChan: fromServerChan,
+		clock:              clock.Real(),
 		tables:             make(map[id.TableID]*table.Table),
+		queues:             make(map[id.TableID]*tableQueue),
 		players:            make(map[id.PlayerID]*player.Player),
+		playerTables:       make(map[id.PlayerID]id.TableID),
+		idleSince:          make(map[id.TableID]time.Time),
 		defaultPlayerBank:  10000,
 	}
 }
@@ -79,20 +124,43 @@ func (m *Manager) Run(ctx context.Context) error {
 	}
 	defer closer.Close()
 
+	if err := m.openUserStore(); err != nil {
+		return err
+	}
+	defer m.userStore.Close()
+
 	m.startServers(ctx, m.fromGrpcServerChan)
-	m.createTables()
+	if err := m.createTables(); err != nil {
+		return err
+	}
 	m.startTables()
 
+	go m.housekeeping(ctx)
+
 	m.l.Info("Starting manager loop...")
 
-	ticker := time.NewTicker(*tickDelay)
+	for {
+		select {
+		case in := <-m.fromGrpcServerChan:
+			m.dispatch(in)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// housekeeping balances tournament tables, closes idle tables and offers seats to the waitlist
+// It runs on its own goroutine, a slow table does not hold up the requests of the others.
+func (m *Manager) housekeeping(ctx context.Context) {
+	ticker := m.clock.NewTicker(*tickDelay)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
-			if err := m.tick(); err != nil {
-				return err
-			}
+		case <-ticker.C():
+			m.tick()
+		case <-ctx.Done():
+			return
 		}
 	}
 }
@@ -139,30 +207,113 @@ func (m *Manager) enableTracer() (io.Closer, error) {
 	return closer, nil
 }
 
-func (m *Manager) createTables() {
-	m.l.Infof("Creating %v tables...", numTables)
-	for i := 0; i < numTables; i++ {
-		t := m.createTable()
+func (m *Manager) createTables() error {
+	variant, ok := ppb.GameVariant_value[*gameVariant]
+	if !ok {
+		return fmt.Errorf("invalid game variant: %v", *gameVariant)
+	}
+
+	structure := table.DefaultBettingStructure(ppb.GameVariant(variant))
+	if *betting != "" {
+		s, ok := ppb.BettingStructure_value[*betting]
+		if !ok {
+			return fmt.Errorf("invalid betting structure: %v", *betting)
+		}
+		structure = ppb.BettingStructure(s)
+	}
+
+	m.variant = ppb.GameVariant(variant)
+	m.bettingStructure = structure
+
+	if *mtt {
+		c := m.tournamentConfig()
+		c.Entrants = *mttEntrants
+		c.TableSize = *mttTableSize
+		return m.createTournamentTables(c)
+	}
+
+	m.l.Infof("Creating %v tables (%v, %v)...", *numTables, *gameVariant, structure)
+	for i := 0; i < *numTables; i++ {
+		t, err := m.createTable()
+		if err != nil {
+			return err
+		}
 		m.tables[t.ID] = t
 	}
+	return nil
 }
 
-func (m *Manager) createTable() *table.Table {
+// createTable creates a cash table, or a sit-and-go table if requested
+func (m *Manager) createTable() (*table.Table, error) {
 	ta := make(chan table.ActionRequest)
-	return table.New(ta)
+	bs := table.NewBettingStructure(m.bettingStructure)
+
+	if !*sitngo {
+		return table.New(ta, m.defaultTableConfig()), nil
+	}
+
+	c := m.tournamentConfig()
+	c.Entrants = *sngEntrants
+	if c.Entrants < len(c.Payouts) {
+		// pay the winner only
+		c.Payouts = []int64{100}
+	}
+
+	return table.NewTournament(ta, m.variant, bs, c)
+}
+
+// defaultTableConfig returns the config of the cash tables set by the flags
+func (m *Manager) defaultTableConfig() table.TableConfig {
+	c := table.DefaultTableConfig()
+	c.Variant = m.variant
+	c.BettingStructure = m.bettingStructure
+	c.Clock = m.clock
+
+	return c
+}
+
+// tournamentConfig returns the tournament config set by the flags
+func (m *Manager) tournamentConfig() table.TournamentConfig {
+	c := table.DefaultTournamentConfig()
+	c.BuyIn = *sngBuyin
+	c.StartingChips = *sngStartingChips
+	c.LevelDuration = *sngLevelDuration
+	c.LevelHands = *sngLevelHands
+	c.Clock = m.clock
+
+	return c
 }
 
 func (m *Manager) startTables() {
-	for i, t := range m.tables {
-		m.l.Infof("Starting table [%v]", t.Name)
-		go func(t *table.Table, i id.TableID) {
-			if err := t.Run(); err != nil {
-				m.l.Errorf("Table [%v] returned error: %v", i, err)
-			}
-		}(t, i)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, t := range m.tables {
+		m.startTable(t)
 	}
 }
 
+// startTable starts the table and its request queue, m.mu must be held
+func (m *Manager) startTable(t *table.Table) {
+	m.l.Infof("Starting table [%v]", t.Name)
+	q := newTableQueue(t)
+	m.queues[t.ID] = q
+	go q.run()
+
+	go func(t *table.Table, i id.TableID) {
+		if err := t.Run(); err != nil {
+			m.l.Errorf("Table [%v] returned error: %v", i, err)
+
+			// a halted table is closed, stop routing requests to it
+			m.mu.Lock()
+			if m.tables[i] == t {
+				m.removeTable(t)
+			}
+			m.mu.Unlock()
+		}
+	}(t, t.ID)
+}
+
 // startServers start grpc and http servers
 func (m *Manager) startServers(ctx context.Context, serverChan chan actions.PlayerAction) {
 	m.l.Info("Starting gRPC and HTTP server...")
@@ -174,210 +325,62 @@ func (m *Manager) startServers(ctx context.Context, serverChan chan actions.Play
 	}()
 }
 
-// tick is one pass through the manager
-func (m *Manager) tick() error {
-	m.processPlayerRequests()
-
-	return nil
-}
-
-// processPlayerRequests processes requests sent from the player via the grpc server
-func (m *Manager) processPlayerRequests() {
+// tick is one housekeeping pass through the manager
+func (m *Manager) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), *tableTimeout)
+	defer cancel()
 
-	var p *player.Player
-	var t *table.Table
-	var err error
-
-	select {
-	case in := <-m.fromGrpcServerChan:
-		playerName := in.ClientInfo.PlayerName
-		playerUsername := in.ClientInfo.PlayerUsername
-		playerID := id.PlayerID(in.ClientInfo.PlayerID)
-		tableID := id.TableID(in.ClientInfo.TableID)
-		playerAction := in.Action
-
-		if playerID != "" {
-			if p, err = m.playerByID(playerID); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				return
-			}
+	if m.tournament != nil && m.clock.Since(m.lastBalance) > *balanceDelay {
+		m.lastBalance = m.clock.Now()
+		if err := m.balanceTournament(ctx); err != nil {
+			m.l.Error(err)
 		}
+	}
 
-		if tableID != "" {
-			if t, err = m.tableByID(tableID); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				return
+	m.closeIdleTables(ctx)
+	m.processWaitlist(ctx)
+}
 
-			}
-		}
+// listTables returns the lobby view of the tables matching the filter, sorted by name
+// The tables publish their summary, so this never waits on a table.
+func (m *Manager) listTables(filter *ppb.TableFilter) *ppb.ListTablesResponse {
+	res := &ppb.ListTablesResponse{}
 
-		m.l.Infof("[%v] Received request from player: %#v", playerUsername, playerAction.String())
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		if !users.Check(playerUsername) {
-			err = fmt.Errorf("invalid username or password")
-			in.ResultC <- actions.NewPlayerActionError(err)
-			return
+	for _, t := range m.tables {
+		if t.Private() {
+			continue
 		}
-
-		switch playerAction {
-		case proto.PlayerAction_PlayerActionNone:
-			m.l.Infof("[%v] player sent empty action...", playerName)
-			break
-
-		case proto.PlayerAction_PlayerActionRegister:
-			if p, err = m.addPlayer(in); err != nil {
-				if errors.Is(err, actions.ErrUserExists) {
-					m.l.Infof("[%v] already registered...", p.Name)
-					// user already registered
-					result := actions.NewPlayerActionResult(nil, &ppb.RegisterResponse{
-						PlayerID: p.ID.String(),
-					})
-					in.ResultC <- result
-					break
-				}
-				// actual error
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			// new regisration
-			result := actions.NewPlayerActionResult(err, &ppb.RegisterResponse{
-				PlayerID: p.ID.String(),
-			})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionDisconnect:
-			if err = m.disconnectPlayer(in.Ctx, p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.DisconnectResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionJoinTable:
-			var pos int
-			if tableID, pos, err = m.joinTable(in.Ctx, p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.JoinTableResponse{
-				TableID:  tableID.String(),
-				Position: int64(pos),
-			})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionPlay:
-			if err := m.registerPlayerCC(p, t, in.ToClientChan); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.JoinTableResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionAckToken:
-			if err := m.playerAckToken(p, t, in.Opts.AckToken); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.AckTokenResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionBuyIn:
-			if err := m.playerBuyIn(p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionCheck:
-			if err := m.playerCheck(p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionFold:
-			if err := m.playerFold(p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			m.l.Info("Sending reply back to player")
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionCall:
-			if err := m.playerCall(p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionAllIn:
-			if err := m.playerAllIn(p, t); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			in.ResultC <- result
-
-		case proto.PlayerAction_PlayerActionBet:
-			amount := in.Opts.GetBetAmount()
-			if err := m.playerBet(p, t, amount); err != nil {
-				m.l.Error(err)
-				in.ResultC <- actions.NewPlayerActionError(err)
-				break
-			}
-			result := actions.NewPlayerActionResult(err, &ppb.TakeTurnResponse{})
-			in.ResultC <- result
-		default:
-			// m.l.Infof("[%v] Doing action: %v", playerName, playerAction.String())
+		if s := t.Summary(); table.MatchesFilter(s, filter) {
+			res.Tables = append(res.Tables, s)
 		}
-
-	default:
 	}
-}
-
-// playerAckToken acks the token for the player at the table
-func (m *Manager) playerAckToken(p *player.Player, t *table.Table, token string) error {
-	// Table response comes back over this channel
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionAckToken, result, p, token)
 
-	t.TableAction <- req
-
-	// block(!?) until table responds
-	res := <-result
-	return res.Err
+	sort.Slice(res.Tables, func(i, j int) bool {
+		if res.Tables[i].GetTableName() == res.Tables[j].GetTableName() {
+			return res.Tables[i].GetTableID() < res.Tables[j].GetTableID()
+		}
+		return res.Tables[i].GetTableName() < res.Tables[j].GetTableName()
+	})
+	return res
 }
 
-// registerPlayerCC registers the player channel and starts streaming game data to it
-func (m *Manager) registerPlayerCC(p *player.Player, t *table.Table, cc chan actions.GameData) error {
-	// Table response comes back over this channel
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionRegisterPlayerCC, result, p, cc)
-
-	t.TableAction <- req
+// watchTable adds a spectator to the table
+func (m *Manager) watchTable(ctx context.Context, t *table.Table, username, password string, out chan *ppb.GameInfo, delay time.Duration, done <-chan struct{}) error {
+	if t == nil {
+		return fmt.Errorf("tableID is required to watch a table")
+	}
+	if err := t.CanJoin(username, password); err != nil {
+		return err
+	}
 
-	// block(!?) until table responds
-	res := <-result
+	res := m.tableRequest(ctx, t, actions.ActionWatch, nil, table.NewWatcher(out, delay, done))
 	return res.Err
 }
 
-// tableByID returns the table with the given id
+// tableByID returns the table with the given id, m.mu must be held
 func (m *Manager) tableByID(tableID id.TableID) (*table.Table, error) {
 	if t, ok := m.tables[tableID]; ok {
 		return t, nil
@@ -385,7 +388,7 @@ func (m *Manager) tableByID(tableID id.TableID) (*table.Table, error) {
 	return nil, fmt.Errorf("cannot find table with id [%v]", tableID)
 }
 
-// playerByID returns the player by ID
+// playerByID returns the player by ID, m.mu must be held
 func (m *Manager) playerByID(playerID id.PlayerID) (*player.Player, error) {
 	if p, ok := m.players[playerID]; ok {
 		return p, nil
@@ -393,82 +396,10 @@ func (m *Manager) playerByID(playerID id.PlayerID) (*player.Player, error) {
 	return nil, fmt.Errorf("player with id [%v] not found", playerID)
 }
 
-// playerBuyIn sends the BuyIn action to the table
-func (m *Manager) playerBuyIn(p *player.Player, t *table.Table) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionBuyIn, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// playerCheck sends the Check action to the table
-func (m *Manager) playerCheck(p *player.Player, t *table.Table) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionCheck, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// playerFold sends the Fold action to the table
-func (m *Manager) playerFold(p *player.Player, t *table.Table) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionFold, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// playerCall sends the Call action to the table
-func (m *Manager) playerCall(p *player.Player, t *table.Table) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionCall, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// playerAllIn sends the AllIn action to the table
-func (m *Manager) playerAllIn(p *player.Player, t *table.Table) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionAllIn, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// playerBet sends the Bet action to the table
-func (m *Manager) playerBet(p *player.Player, t *table.Table, amount int64) error {
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionBet, result, p, amount)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
-
-	return res.Err
-}
-
-// jointable attempts to join a table
-func (m *Manager) joinTable(ctx context.Context, p *player.Player, t *table.Table) (tableID id.TableID, pos int, err error) {
+// jointable attempts to join a table and buy in
+// The password is only needed for a private table, and the buyin amount defaults to the table maximum.
+func (m *Manager) joinTable(ctx context.Context, p *player.Player, t *table.Table, opts *ppb.ActionOpts) (tableID id.TableID, pos int, err error) {
 	span, _ := opentracing.StartSpanFromContext(ctx, "joinTable")
-	span.SetTag("playerUsername", p.Username)
 	ext.Component.Set(span, "Manager")
 	defer span.Finish()
 
@@ -479,26 +410,44 @@ func (m *Manager) joinTable(ctx context.Context, p *player.Player, t *table.Tabl
 
 		return "", -1, err
 	}
+	span.SetTag("playerUsername", p.Username)
 
 	pos = -1
 
 	// find available table
-	if t == nil {
-		t, err = m.firstAvailableTable()
-		if err != nil {
-			span.LogFields(log.String("error", err.Error()))
-			ext.Error.Set(span, true)
-			return
+	switch {
+	case t == nil && m.tournament != nil:
+		t, err = m.emptiestAvailableTable(ctx)
+	case t == nil:
+		t, err = m.firstAvailableTable(ctx)
+		if err != nil && *sitngo {
+			// all sit-and-go tables are running, start a new one
+			m.l.Info("No sit-and-go table available, creating a new one...")
+			if t, err = m.createTable(); err == nil {
+				m.mu.Lock()
+				m.tables[t.ID] = t
+				m.startTable(t)
+				m.mu.Unlock()
+			}
 		}
 	}
+	if err == nil {
+		err = t.CanJoin(p.Username, opts.GetTablePassword())
+	}
+	if err == nil {
+		err = m.checkHeldSeats(ctx, t, p)
+	}
+	if err != nil {
+		span.LogFields(log.String("error", err.Error()))
+		ext.Error.Set(span, true)
+		return
+	}
 
-	// Table response comes back over this channel
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionAddPlayer, result, p, nil)
-	t.TableAction <- req
-
-	// block(!?) until table responds
-	res := <-result
+	// seats are numbered from 1 in the API, 0 picks any seat
+	res := m.tableRequest(ctx, t, actions.ActionAddPlayer, p, table.AddPlayerOpts{
+		Buyin: opts.GetBuyinAmount(),
+		Seat:  int(opts.GetSeat()) - 1,
+	})
 	err = res.Err
 	if err != nil {
 		span.LogFields(log.String("error", err.Error()))
@@ -507,26 +456,48 @@ func (m *Manager) joinTable(ctx context.Context, p *player.Player, t *table.Tabl
 	}
 
 	span.SetTag("table", t.Name)
+	m.mu.Lock()
+	m.playerTables[p.ID] = t.ID
+	m.mu.Unlock()
 	r := res.Result.(table.ActionAddPlayerResult)
+
+	if opts.GetAutoRebuy() != nil {
+		if res := m.tableRequest(ctx, t, actions.ActionAutoRebuy, p, opts.GetAutoRebuy()); res.Err != nil {
+			m.l.Errorf("[%v] %v", p.Name, res.Err)
+		}
+	}
 	return t.ID, r.Position, err
 }
 
-// firstAvailableTable returns the first table with an empty spot for the player
-func (m *Manager) firstAvailableTable() (*table.Table, error) {
+// tableList returns the open tables
+func (m *Manager) tableList() []*table.Table {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tables := make([]*table.Table, 0, len(m.tables))
 	for _, t := range m.tables {
+		tables = append(tables, t)
+	}
+	return tables
+}
 
-		result := make(chan table.ActionResult)
-		req := table.NewTableAction(actions.ActionInfo, result, nil, nil)
-		t.TableAction <- req
+// removeTable stops the table and forgets about it, m.mu must be held
+func (m *Manager) removeTable(t *table.Table) {
+	delete(m.tables, t.ID)
+	delete(m.queues, t.ID)
+	delete(m.idleSince, t.ID)
+	t.Stop()
+}
 
-		res := <-result
-		err := res.Err
+// firstAvailableTable returns the first table with an empty spot for the player
+func (m *Manager) firstAvailableTable(ctx context.Context) (*table.Table, error) {
+	for _, t := range m.tableList() {
+		r, err := m.tableInfo(ctx, t)
 		if err != nil {
 			return nil, err
 		}
 
-		r := res.Result.(table.ActionInfoResult)
-		if r.AvailableToJoin {
+		if r.AvailableToJoin && !r.Private {
 			return t, nil
 		}
 	}
@@ -534,28 +505,74 @@ func (m *Manager) firstAvailableTable() (*table.Table, error) {
 	return nil, fmt.Errorf("unable to find free table")
 }
 
-// disconnectPlayer handles a player that disconnected
-func (m *Manager) disconnectPlayer(ctx context.Context, p *player.Player, t *table.Table) error {
+// tableInfo returns the table info
+func (m *Manager) tableInfo(ctx context.Context, t *table.Table) (table.ActionInfoResult, error) {
+	res := m.tableRequest(ctx, t, actions.ActionInfo, nil, nil)
+	if res.Err != nil {
+		return table.ActionInfoResult{}, res.Err
+	}
+	return res.Result.(table.ActionInfoResult), nil
+}
+
+// tableRequest sends the action to the table and waits for the answer, until the context is done or the table stops
+// A request the table took may still be carried out after the context is done.
+func (m *Manager) tableRequest(ctx context.Context, t *table.Table, a actions.TableAction, p *player.Player, opts interface{}) table.ActionResult {
+	// the table never blocks on a request that was given up on
+	result := make(chan table.ActionResult, 1)
+	req := table.NewTableAction(a, result, p, opts)
+
+	select {
+	case t.TableAction <- req:
+	case <-ctx.Done():
+		return table.NewTableActionResult(fmt.Errorf("table [%v] did not take the %v request: %v", t.Name, a, ctx.Err()), nil)
+	case <-t.Done():
+		return table.NewTableActionResult(fmt.Errorf("table [%v] is closed", t.Name), nil)
+	}
+
+	select {
+	case res := <-result:
+		return res
+	case <-ctx.Done():
+		return table.NewTableActionResult(fmt.Errorf("table [%v] did not answer the %v request: %v", t.Name, a, ctx.Err()), nil)
+	case <-t.Done():
+		return table.NewTableActionResult(fmt.Errorf("table [%v] is closed", t.Name), nil)
+	}
+}
+
+// disconnectPlayer handles a player that disconnected from the table they are at
+// The table holds the seat for a while, for the player to reconnect with a new Play stream; cc is the channel of the
+// stream that ended.
+func (m *Manager) disconnectPlayer(ctx context.Context, p *player.Player, t *table.Table, cc chan actions.GameData) error {
 
 	span, _ := opentracing.StartSpanFromContext(ctx, "disconnectPlayer")
 	span.SetTag("playerUsername", p.Username)
 	ext.Component.Set(span, "Manager")
 	defer span.Finish()
 
-	result := make(chan table.ActionResult)
-	req := table.NewTableAction(actions.ActionDisconnect, result, p, nil)
-	t.TableAction <- req
-
-	// block until response
-	res := <-result
+	res := m.tableRequest(ctx, t, actions.ActionDisconnect, p, cc)
 
 	if res.Err != nil {
 		span.LogFields(log.String("error", res.Err.Error()))
 		ext.Error.Set(span, true)
 	}
+	if held, _ := res.Result.(bool); !held {
+		m.mu.Lock()
+		delete(m.playerTables, p.ID)
+		m.mu.Unlock()
+	}
 	return res.Err
 }
 
+// currentTable returns the table the player was last seated at, t if unknown; m.mu must be held
+func (m *Manager) currentTable(p *player.Player, t *table.Table) *table.Table {
+	if tableID, ok := m.playerTables[p.ID]; ok {
+		if current, err := m.tableByID(tableID); err == nil {
+			return current
+		}
+	}
+	return t
+}
+
 // addPlayer add the player to the manager instance and make them available for playing games
 // player must exist in the userdb
 func (m *Manager) addPlayer(in actions.PlayerAction) (*player.Player, error) {
@@ -564,13 +581,16 @@ func (m *Manager) addPlayer(in actions.PlayerAction) (*player.Player, error) {
 	ext.Component.Set(span, "Manager")
 	defer span.Finish()
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	username := in.ClientInfo.PlayerUsername
 	if m.havePlayerUsername(username) {
 		return m.getPlayerByUsername(username), actions.ErrUserExists
 	}
 
 	m.l.Infof("[%v] Checking for playing in userdb...", username)
-	u, err := users.Load(username)
+	u, err := m.userStore.Load(username)
 	if err != nil {
 		ext.Error.Set(span, true)
 		span.LogFields(
@@ -581,11 +601,34 @@ func (m *Manager) addPlayer(in actions.PlayerAction) (*player.Player, error) {
 
 	m.l.Infof("[%v] Adding player to manager...", username)
 	p := player.New(u)
+	p.PersistBank(m.userStore)
+
+	// the bank comes from the user store, outside the game
+	if err := table.Ledger().Transfer(id.EmptyTableID, ledger.External(), ledger.Bank(p.ID), u.Bank, "opening balance"); err != nil {
+		return nil, err
+	}
 
 	m.players[p.ID] = p
 	return p, nil
 }
 
+// openUserStore opens the store the users and their bank balances are kept in
+func (m *Manager) openUserStore() error {
+	if *userDB == "" {
+		m.l.Info("Keeping users in memory, bank balances are lost on restart")
+		m.userStore = users.NewMemoryStore()
+		return nil
+	}
+
+	m.l.Infof("Loading users from [%v]...", *userDB)
+	s, err := users.NewBoltStore(*userDB)
+	if err != nil {
+		return err
+	}
+	m.userStore = s
+	return nil
+}
+
 // havePlayerUsername returns true if there is a player with the given username already in the manager
 func (m *Manager) havePlayerUsername(username string) bool {
 	for _, p := range m.players {
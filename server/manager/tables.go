@@ -0,0 +1,101 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// canCreateTables returns true if the user is allowed to create tables
+func canCreateTables(username string) bool {
+	if *tableCreators == "" {
+		return true
+	}
+
+	for _, u := range strings.Split(*tableCreators, ",") {
+		if strings.TrimSpace(u) == username {
+			return true
+		}
+	}
+	return false
+}
+
+// createPlayerTable creates and starts a cash table requested by a player
+func (m *Manager) createPlayerTable(username string, in *ppb.TableConfig) (*table.Table, error) {
+	if !canCreateTables(username) {
+		return nil, fmt.Errorf("[%v] is not allowed to create tables", username)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.tables) >= *maxTables {
+		return nil, fmt.Errorf("too many tables (max: %v)", *maxTables)
+	}
+
+	c := table.TableConfigFromProto(in, m.defaultTableConfig())
+	c.Owner = username
+	c.IdleTimeout = *tableIdleTimeout
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	t := table.New(make(chan table.ActionRequest), c)
+	m.tables[t.ID] = t
+
+	m.l.Infof("[%v] created table [%v] (%v, %v, $%v/$%v, private: %t)", username, t.Name, c.Variant, c.BettingStructure, c.SmallBlind, c.BigBlind, c.Private)
+	m.startTable(t)
+
+	return t, nil
+}
+
+// closeIdleTables tears down the tables that have been empty for longer than their idle timeout
+func (m *Manager) closeIdleTables(ctx context.Context) {
+	now := m.clock.Now()
+
+	m.mu.Lock()
+	var idle []*table.Table
+	for _, t := range m.tables {
+		timeout := t.IdleTimeout()
+		if timeout == 0 {
+			continue
+		}
+
+		if t.Summary().GetPlayers() > 0 {
+			delete(m.idleSince, t.ID)
+			continue
+		}
+
+		since, ok := m.idleSince[t.ID]
+		if !ok {
+			m.idleSince[t.ID] = now
+			continue
+		}
+		if now.Sub(since) >= timeout {
+			idle = append(idle, t)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, t := range idle {
+		// the summary may not show a player that just joined yet
+		r, err := m.tableInfo(ctx, t)
+
+		m.mu.Lock()
+		if err != nil || r.Players > 0 {
+			delete(m.idleSince, t.ID)
+			m.mu.Unlock()
+			continue
+		}
+
+		m.l.Infof("Table [%v] has been empty for %v, closing it", t.Name, now.Sub(m.idleSince[t.ID]).Truncate(time.Second))
+		m.removeTable(t)
+		m.mu.Unlock()
+	}
+}
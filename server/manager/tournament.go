@@ -0,0 +1,209 @@
+package manager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+)
+
+var (
+	mtt          = flag.Bool("mtt", false, "run a single multi-table tournament instead of cash tables; uses the sng_ flags for buyin, chips and levels")
+	mttEntrants  = flag.Int("mtt_entrants", 18, "number of players in the multi-table tournament")
+	mttTableSize = flag.Int("mtt_table_size", 6, "number of seats at each multi-table tournament table")
+
+	balanceDelay = flag.Duration("mtt_balance_delay", time.Second*2, "delay between multi-table tournament table balancing passes")
+)
+
+// tableLoad is the number of tournament players at a table
+type tableLoad struct {
+	id      id.TableID
+	players int
+	seats   int
+}
+
+// tableMove moves one player between tables
+type tableMove struct {
+	from, to id.TableID
+}
+
+// balanceMoves returns the moves that break tables down to as few as can seat all players,
+// and then keep the remaining tables within one player of each other
+func balanceMoves(loads []tableLoad) []tableMove {
+	if len(loads) < 2 {
+		return nil
+	}
+
+	l := make([]tableLoad, len(loads))
+	copy(l, loads)
+
+	var total int
+	for _, t := range l {
+		total += t.players
+	}
+	seats := l[0].seats
+	need := (total + seats - 1) / seats
+	if need < 1 {
+		need = 1
+	}
+
+	sortLoads := func() {
+		sort.Slice(l, func(i, j int) bool {
+			if l[i].players == l[j].players {
+				return l[i].id < l[j].id
+			}
+			return l[i].players < l[j].players
+		})
+	}
+
+	var moves []tableMove
+
+	// break the smallest tables, moving each player to the emptiest remaining table
+	for len(l) > need {
+		sortLoads()
+		broken := l[0]
+		l = l[1:]
+
+		for i := 0; i < broken.players; i++ {
+			sortLoads()
+			l[0].players++
+			moves = append(moves, tableMove{from: broken.id, to: l[0].id})
+		}
+	}
+
+	// move players from the fullest to the emptiest table
+	for {
+		sortLoads()
+		last := len(l) - 1
+		if l[last].players-l[0].players <= 1 {
+			break
+		}
+		l[last].players--
+		l[0].players++
+		moves = append(moves, tableMove{from: l[last].id, to: l[0].id})
+	}
+
+	return moves
+}
+
+// createTournamentTables creates the tables of the multi-table tournament
+func (m *Manager) createTournamentTables(c table.TournamentConfig) error {
+	tr, err := table.NewMultiTableTournament(c)
+	if err != nil {
+		return err
+	}
+	m.tournament = tr
+
+	m.l.Infof("Creating %v tables for a %v player tournament (%v, %v)...", c.Tables(), c.Entrants, m.variant, m.bettingStructure)
+	for i := 0; i < c.Tables(); i++ {
+		ta := make(chan table.ActionRequest)
+		t := tr.NewTable(ta, m.variant, table.NewBettingStructure(m.bettingStructure))
+		m.tables[t.ID] = t
+	}
+	return nil
+}
+
+// emptiestAvailableTable returns the table with a free seat and the fewest players, used to spread
+// tournament entrants evenly across the tables
+func (m *Manager) emptiestAvailableTable(ctx context.Context) (*table.Table, error) {
+	var best *table.Table
+	var bestPlayers int
+
+	for _, t := range m.tableList() {
+		r, err := m.tableInfo(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		if !r.AvailableToJoin {
+			continue
+		}
+		if best == nil || r.TournamentPlayers < bestPlayers || (r.TournamentPlayers == bestPlayers && t.ID < best.ID) {
+			best = t
+			bestPlayers = r.TournamentPlayers
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("unable to find free table")
+	}
+	return best, nil
+}
+
+// balanceTournament removes empty tournament tables and moves players to keep the rest balanced
+func (m *Manager) balanceTournament(ctx context.Context) error {
+	if m.tournament == nil || !m.tournament.Started() || m.tournament.Complete() {
+		return nil
+	}
+
+	tables := m.tableList()
+	open := len(tables)
+	byID := make(map[id.TableID]*table.Table)
+
+	var loads []tableLoad
+	for _, t := range tables {
+		r, err := m.tableInfo(ctx, t)
+		if err != nil {
+			return err
+		}
+
+		if r.TournamentPlayers == 0 && open > 1 {
+			m.l.Infof("Tournament table [%v] is empty, closing it", t.Name)
+			m.mu.Lock()
+			m.removeTable(t)
+			m.mu.Unlock()
+			open--
+			continue
+		}
+		byID[t.ID] = t
+
+		loads = append(loads, tableLoad{
+			id:      t.ID,
+			players: r.TournamentPlayers,
+			seats:   r.MaxPlayers,
+		})
+	}
+
+	for _, mv := range balanceMoves(loads) {
+		if err := m.movePlayer(ctx, byID[mv.from], byID[mv.to]); err != nil {
+			// most likely the player is in a hand, try again on the next pass
+			m.l.Debugf("unable to move player: %v", err)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// movePlayer moves a tournament player between tables
+func (m *Manager) movePlayer(ctx context.Context, from, to *table.Table) error {
+	res := m.tableRequest(ctx, from, actions.ActionReleasePlayer, nil, nil)
+	if res.Err != nil {
+		return res.Err
+	}
+	p := res.Result.(*player.Player)
+
+	// the released player is seated again even if the balancing pass ran out of time
+	ctx, cancel := context.WithTimeout(context.Background(), *tableTimeout)
+	defer cancel()
+
+	res = m.tableRequest(ctx, to, actions.ActionSeatMovedPlayer, p, from.ID)
+	if res.Err != nil {
+		m.l.Errorf("unable to seat [%v] at table [%v], returning them to table [%v]: %v", p.Name, to.Name, from.Name, res.Err)
+		if back := m.tableRequest(ctx, from, actions.ActionSeatMovedPlayer, p, id.TableID("")); back.Err != nil {
+			return fmt.Errorf("unable to return [%v] to table [%v]: %v", p.Name, from.Name, back.Err)
+		}
+		return res.Err
+	}
+
+	m.l.Infof("[%v] moved from table [%v] to table [%v]", p.Name, from.Name, to.Name)
+	m.mu.Lock()
+	m.playerTables[p.ID] = to.ID
+	m.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,74 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+)
+
+func TestBalanceMoves(t *testing.T) {
+	tests := []struct {
+		name  string
+		loads []tableLoad
+		// players at each table after the moves
+		want map[id.TableID]int
+	}{
+		{
+			name: "balanced tables stay put",
+			loads: []tableLoad{
+				{id: "a", players: 5, seats: 6},
+				{id: "b", players: 4, seats: 6},
+				{id: "c", players: 5, seats: 6},
+			},
+			want: map[id.TableID]int{"a": 5, "b": 4, "c": 5},
+		},
+		{
+			name: "move from the fullest to the emptiest table",
+			loads: []tableLoad{
+				{id: "a", players: 6, seats: 6},
+				{id: "b", players: 3, seats: 6},
+				{id: "c", players: 5, seats: 6},
+			},
+			want: map[id.TableID]int{"a": 5, "b": 4, "c": 5},
+		},
+		{
+			name: "break a table once the others can seat everyone",
+			loads: []tableLoad{
+				{id: "a", players: 4, seats: 6},
+				{id: "b", players: 3, seats: 6},
+				{id: "c", players: 4, seats: 6},
+			},
+			want: map[id.TableID]int{"a": 6, "b": 0, "c": 5},
+		},
+		{
+			name: "merge down to the final table",
+			loads: []tableLoad{
+				{id: "a", players: 3, seats: 6},
+				{id: "b", players: 3, seats: 6},
+			},
+			want: map[id.TableID]int{"a": 0, "b": 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[id.TableID]int{}
+			for _, l := range tt.loads {
+				got[l.id] = l.players
+			}
+
+			for _, mv := range balanceMoves(tt.loads) {
+				got[mv.from]--
+				got[mv.to]++
+				if got[mv.to] > 6 {
+					t.Fatalf("move to full table [%v]", mv.to)
+				}
+			}
+
+			for tid, n := range tt.want {
+				if got[tid] != n {
+					t.Errorf("table [%v] has %d players, want %d (all: %v)", tid, got[tid], n, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package manager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/acks"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+var (
+	waitlistOfferTimeout = flag.Duration("waitlist_offer_timeout", time.Second*15, "time a waitlisted player has to take an offered seat before it is offered to the next player")
+)
+
+// waitlistEntry is a player queued for a seat
+type waitlistEntry struct {
+	p *player.Player
+
+	// a specific table, or any table matching the filter if empty
+	tableID id.TableID
+	filter  *ppb.TableFilter
+	// join options used once the player takes the seat
+	opts *ppb.ActionOpts
+
+	out  chan *ppb.WaitlistUpdate
+	done <-chan struct{}
+
+	// the table the seat is offered at, and the token the player acks to take it
+	offer      *table.Table
+	offerToken *acks.Token
+
+	lastPosition int
+}
+
+// matches returns true if the player is waiting for a seat at the table
+func (e *waitlistEntry) matches(t *table.Table, s *ppb.TableSummary) bool {
+	if e.tableID != "" {
+		return e.tableID == t.ID
+	}
+	return !t.Private() && table.MatchesFilter(s, e.filter)
+}
+
+// send sends an update to the player, the manager never blocks on a slow client
+func (e *waitlistEntry) send(u *ppb.WaitlistUpdate) {
+	select {
+	case e.out <- u:
+	default:
+	}
+}
+
+// joinWaitlist queues the player for a seat
+func (m *Manager) joinWaitlist(p *player.Player, t *table.Table, filter *ppb.TableFilter, opts *ppb.ActionOpts, out chan *ppb.WaitlistUpdate, done <-chan struct{}) error {
+	if p == nil {
+		return fmt.Errorf("playerID is required to join the waitlist")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.waitlist {
+		if e.p == p {
+			return fmt.Errorf("[%v] is already on the waitlist", p.Name)
+		}
+	}
+
+	e := &waitlistEntry{
+		p:      p,
+		filter: filter,
+		opts:   opts,
+		out:    out,
+		done:   done,
+	}
+	if t != nil {
+		if t.Summary().GetTournament() {
+			return fmt.Errorf("there is no waitlist at tournament tables")
+		}
+		if err := t.CanJoin(p.Username, opts.GetTablePassword()); err != nil {
+			return err
+		}
+		e.tableID = t.ID
+	}
+
+	m.l.Infof("[%v] joined the waitlist (table: %v, filter: %v)", p.Name, e.tableID, filter)
+	m.waitlist = append(m.waitlist, e)
+	return nil
+}
+
+// processWaitlist offers free seats to the waitlisted players in turn, called every tick
+// A seat is held for the offered player until the offer token expires.
+func (m *Manager) processWaitlist(ctx context.Context) {
+	m.mu.Lock()
+	if len(m.waitlist) == 0 {
+		m.mu.Unlock()
+		return
+	}
+
+	waitlist := m.waitlist[:0]
+	for _, e := range m.waitlist {
+		select {
+		case <-e.done:
+			m.l.Infof("[%v] left the waitlist", e.p.Name)
+			continue
+		default:
+		}
+
+		if e.offer != nil && e.offerToken.Expired() {
+			m.l.Infof("[%v] did not take the seat at table [%v], offering it to the next player", e.p.Name, e.offer.Name)
+			close(e.out)
+			continue
+		}
+		waitlist = append(waitlist, e)
+	}
+	m.waitlist = waitlist
+
+	var waiting []*table.Table
+	for _, t := range m.tables {
+		// the summary is cheap but can lag a tick, the table is asked before a seat is offered
+		s := t.Summary()
+		if s.GetTournament() || s.GetPlayers() >= s.GetMaxPlayers() || !m.waitingFor(t, s) {
+			continue
+		}
+		waiting = append(waiting, t)
+	}
+	m.mu.Unlock()
+
+	for _, t := range waiting {
+		free, err := m.freeSeats(ctx, t, nil)
+		if err != nil {
+			m.l.Error(err)
+			continue
+		}
+		m.offerSeats(t, free)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// tell the players still waiting where they are in the queue
+	position := 0
+	for _, e := range m.waitlist {
+		if e.offer != nil {
+			continue
+		}
+		position++
+		if e.lastPosition != position {
+			e.lastPosition = position
+			e.send(&ppb.WaitlistUpdate{Position: int64(position)})
+		}
+	}
+}
+
+// offerSeats offers the free seats at the table to the players waiting for it
+func (m *Manager) offerSeats(t *table.Table, free int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := t.Summary()
+	for _, e := range m.waitlist {
+		if free <= 0 {
+			break
+		}
+		if e.offer != nil || !e.matches(t, s) {
+			continue
+		}
+
+		m.l.Infof("[%v] offered a seat at table [%v]", e.p.Name, t.Name)
+		e.offer = t
+		e.offerToken = acks.New(m.clock, []*player.Player{e.p}, *waitlistOfferTimeout)
+		e.offerToken.StartTimer()
+		free--
+
+		e.send(&ppb.WaitlistUpdate{
+			OfferTableID:      t.ID.String(),
+			OfferTableName:    t.Name,
+			OfferToken:        e.offerToken.String(),
+			OfferExpiresInSec: int64(e.offerToken.TimeRemaining().Seconds()),
+		})
+	}
+}
+
+// waitingFor returns true if a player without a seat offer is waiting for the table, m.mu must be held
+func (m *Manager) waitingFor(t *table.Table, s *ppb.TableSummary) bool {
+	for _, e := range m.waitlist {
+		if e.offer == nil && e.matches(t, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// freeSeats returns the number of free seats at the table that are not offered to waitlisted players other than p
+func (m *Manager) freeSeats(ctx context.Context, t *table.Table, p *player.Player) (int, error) {
+	r, err := m.tableInfo(ctx, t)
+	if err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return r.MaxPlayers - r.Players - m.heldSeats(t, p), nil
+}
+
+// heldSeats returns the number of seats at the table offered to waitlisted players other than p, m.mu must be held
+func (m *Manager) heldSeats(t *table.Table, p *player.Player) int {
+	var held int
+	for _, e := range m.waitlist {
+		if e.offer == t && e.p != p {
+			held++
+		}
+	}
+	return held
+}
+
+// checkHeldSeats returns an error if all free seats at the table are offered to other waitlisted players
+func (m *Manager) checkHeldSeats(ctx context.Context, t *table.Table, p *player.Player) error {
+	m.mu.Lock()
+	held := m.heldSeats(t, p)
+	m.mu.Unlock()
+
+	if held == 0 {
+		return nil
+	}
+
+	free, err := m.freeSeats(ctx, t, p)
+	if err != nil {
+		return err
+	}
+	if free <= 0 {
+		return fmt.Errorf("the free seats at table [%v] are offered to waitlisted players", t.Name)
+	}
+	return nil
+}
+
+// hasSeatOffer returns true if the token is the player's seat offer, m.mu must be held
+func (m *Manager) hasSeatOffer(p *player.Player, token string) bool {
+	for _, e := range m.waitlist {
+		if e.p == p && e.offer != nil && e.offerToken.String() == token {
+			return true
+		}
+	}
+	return false
+}
+
+// takeOfferedSeat seats the player if the token is the player's seat offer, returns false if it is not
+func (m *Manager) takeOfferedSeat(ctx context.Context, p *player.Player, token string) (bool, error) {
+	m.mu.Lock()
+	var e *waitlistEntry
+	for i, w := range m.waitlist {
+		if w.p != p || w.offer == nil || w.offerToken.String() != token {
+			continue
+		}
+		if w.offerToken.Expired() {
+			m.mu.Unlock()
+			return true, fmt.Errorf("the seat offer at table [%v] expired", w.offer.Name)
+		}
+
+		w.offerToken.Ack(p)
+		m.waitlist = append(m.waitlist[:i], m.waitlist[i+1:]...)
+		e = w
+		break
+	}
+	m.mu.Unlock()
+
+	if e == nil {
+		return false, nil
+	}
+	defer close(e.out)
+
+	tableID, pos, err := m.joinTable(ctx, p, e.offer, e.opts)
+	if err != nil {
+		return true, err
+	}
+
+	m.l.Infof("[%v] took the offered seat at table [%v]", p.Name, e.offer.Name)
+	e.send(&ppb.WaitlistUpdate{
+		Seated: &ppb.JoinTableResponse{
+			TableID:  tableID.String(),
+			Position: int64(pos),
+		},
+	})
+	return true, nil
+}
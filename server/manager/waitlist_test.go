@@ -0,0 +1,58 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestJoinWaitlist(t *testing.T) {
+	m := New()
+
+	public := table.New(make(chan table.ActionRequest), table.DefaultTableConfig())
+	c := table.DefaultTableConfig()
+	c.Private = true
+	c.Password = "secret"
+	private := table.New(make(chan table.ActionRequest), c)
+
+	a := player.New(users.User{Username: "a"})
+	b := player.New(users.User{Username: "b"})
+	out := make(chan *ppb.WaitlistUpdate, 1)
+
+	if err := m.joinWaitlist(a, public, nil, nil, out, nil); err != nil {
+		t.Fatalf("joinWaitlist() = %v", err)
+	}
+	if err := m.joinWaitlist(a, nil, nil, nil, out, nil); err == nil {
+		t.Errorf("joinWaitlist() of a player already waiting = nil, want error")
+	}
+	if err := m.joinWaitlist(b, private, nil, nil, out, nil); err == nil {
+		t.Errorf("joinWaitlist() of a private table without the password = nil, want error")
+	}
+	if err := m.joinWaitlist(b, nil, &ppb.TableFilter{MinBigBlind: 10, MaxBigBlind: 10}, nil, out, nil); err != nil {
+		t.Fatalf("joinWaitlist() = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		entry *waitlistEntry
+		table *table.Table
+		want  bool
+	}{
+		{name: "queued for the table", entry: m.waitlist[0], table: public, want: true},
+		{name: "queued for another table", entry: m.waitlist[0], table: private, want: false},
+		{name: "matching stakes", entry: m.waitlist[1], table: public, want: true},
+		{name: "private tables are never offered by stakes", entry: m.waitlist[1], table: private, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ppb.TableSummary{BigBlind: 10}
+			if got := tt.entry.matches(tt.table, s); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -44,6 +44,10 @@ type handInfo struct {
 	// Keep track if action is required
 	actionRequired bool
 
+	// action queued for the player's next turn this betting round, and the call it was queued for
+	preAction     ppb.PreAction
+	preActionCall int64
+
 	Hole []deck.Card
 	Hand *poker.PlayerHand
 }
@@ -76,10 +80,13 @@ type Player struct {
 
 	LastAction    LastAction
 	TablePosition int
-	WaitSince     time.Time // time the player becam the active player
+	// set when the player was moved from another table, until the player acks a token at the new table
+	MovedFrom id.TableID
+	WaitSince time.Time // time the player becam the active player
 
 	money    *Money
 	iswinner bool
+	seat     seat
 
 	Stats *Stats
 
@@ -99,6 +106,13 @@ func New(u users.User) *Player {
 	}
 }
 
+// PersistBank saves all changes to the player's bank in the user store
+func (p *Player) PersistBank(s users.UserStore) {
+	p.money.saveBank = func(bank int64) error {
+		return s.SetBank(p.Username, bank)
+	}
+}
+
 // String returns ...
 func (p *Player) String() string {
 	return p.Username
@@ -128,6 +142,7 @@ func (p *Player) DisconnectReset() {
 func (p *Player) ResetForBettingRound() {
 	p.Money().SetBetThisRound(0)
 	p.Money().SetWinnings(0)
+	p.ClearPreAction()
 }
 
 // PlayerHand sets the player's final hand
@@ -170,6 +185,17 @@ func (p *Player) AsProto(bigBlind, buyin int64) *ppb.Player {
 		s.State = append(s.State, ppb.PlayerState_PlayerStateFolded)
 	}
 
+	switch p.SeatState() {
+	case SeatSittingOut:
+		s.State = append(s.State, ppb.PlayerState_PlayerStateSittingOut)
+	case SeatLeaving:
+		s.State = append(s.State, ppb.PlayerState_PlayerStateLeaving)
+	}
+	if p.Disconnected() {
+		s.State = append(s.State, ppb.PlayerState_PlayerStateDisconnected)
+	}
+	s.MissedBlinds = p.MissedBlinds()
+
 	if p.Money().Stack() < bigBlind {
 		s.State = append(s.State, ppb.PlayerState_PlayerStateStackEmpty)
 
@@ -202,6 +228,21 @@ func (p *Player) SetLastAction(a actions.TableAction, amount int64) {
 	case actions.ActionAllIn:
 		la.Action = ppb.PlayerAction_PlayerActionAllIn
 		la.Amount = amount
+	case actions.ActionStraddle:
+		la.Action = ppb.PlayerAction_PlayerActionStraddle
+		la.Amount = amount
+	case actions.ActionPostAnte:
+		la.Action = ppb.PlayerAction_PlayerActionPostAnte
+		la.Amount = amount
+	case actions.ActionPostSmallBlind:
+		la.Action = ppb.PlayerAction_PlayerActionPostSmallBlind
+		la.Amount = amount
+	case actions.ActionPostBigBlind:
+		la.Action = ppb.PlayerAction_PlayerActionPostBigBlind
+		la.Amount = amount
+	case actions.ActionPostDeadBlind:
+		la.Action = ppb.PlayerAction_PlayerActionPostDeadBlind
+		la.Amount = amount
 	}
 
 	p.LastAction = la
@@ -1,12 +1,17 @@
 package player
 
 import (
+	"fmt"
+
 	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
 )
 
 // Money keeps track of the player's money during the hand
 type Money struct {
 	bank, stack, betThisRound, winnings int64
+
+	// saveBank, if set, writes the bank to durable storage
+	saveBank func(bank int64) error
 }
 
 // NewMoney returns a new money struct
@@ -21,9 +26,17 @@ func (pm *Money) Bank() int64 {
 	return pm.bank
 }
 
-// SetBank sets the bank
-func (pm *Money) SetBank(b int64) {
+// SetBank sets the bank, and saves it first if the player's bank is persisted
+// The bank is left unchanged if saving fails
+func (pm *Money) SetBank(b int64) error {
+	if pm.saveBank != nil {
+		if err := pm.saveBank(b); err != nil {
+			return fmt.Errorf("unable to save bank: %v", err)
+		}
+	}
+
 	pm.bank = b
+	return nil
 }
 
 // SetStack sets the player's stack
@@ -0,0 +1,19 @@
+package player
+
+import ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+
+// PreAction returns the action the player queued for their next turn, and the amount to call it was queued for
+func (p *Player) PreAction() (ppb.PreAction, int64) {
+	return p.HandInfo.preAction, p.HandInfo.preActionCall
+}
+
+// SetPreAction queues an action for the player's next turn
+func (p *Player) SetPreAction(a ppb.PreAction, call int64) {
+	p.HandInfo.preAction = a
+	p.HandInfo.preActionCall = call
+}
+
+// ClearPreAction cancels the queued action
+func (p *Player) ClearPreAction() {
+	p.SetPreAction(ppb.PreAction_PreActionNone, 0)
+}
@@ -0,0 +1,123 @@
+package player
+
+import "time"
+
+// SeatState is whether a seated player is dealt into the next hand
+type SeatState int
+
+const (
+	// SeatPlaying players are dealt into every hand
+	SeatPlaying SeatState = iota
+	// SeatSittingOut players keep their seat, but are not dealt in
+	SeatSittingOut
+	// SeatLeaving players leave the table once the current hand is done
+	SeatLeaving
+)
+
+func (s SeatState) String() string {
+	switch s {
+	case SeatPlaying:
+		return "playing"
+	case SeatSittingOut:
+		return "sitting out"
+	case SeatLeaving:
+		return "leaving"
+	}
+	return ""
+}
+
+// seat keeps track of a player sitting out
+type seat struct {
+	state SeatState
+
+	// hands left to sit out, 0 sits out until the player sits back in
+	sitOutHands int
+	// blinds that passed while sitting out
+	missedBlinds int64
+	// set while the seat is held for a disconnected player
+	disconnectedAt time.Time
+}
+
+// SeatState returns the state of the player's seat
+func (p *Player) SeatState() SeatState {
+	return p.seat.state
+}
+
+// SittingOut returns true if the player keeps the seat without being dealt in
+func (p *Player) SittingOut() bool {
+	return p.seat.state == SeatSittingOut
+}
+
+// Leaving returns true if the player leaves the table after the current hand
+func (p *Player) Leaving() bool {
+	return p.seat.state == SeatLeaving
+}
+
+// SitOut sits the player out for the next hands, 0 sits out until the player sits back in
+func (p *Player) SitOut(hands int) {
+	p.seat.state = SeatSittingOut
+	p.seat.sitOutHands = hands
+}
+
+// SitIn deals the player into the next hand
+func (p *Player) SitIn() {
+	p.seat.state = SeatPlaying
+	p.seat.sitOutHands = 0
+}
+
+// LeaveAfterHand marks the player to leave once the current hand is done
+func (p *Player) LeaveAfterHand() {
+	p.seat.state = SeatLeaving
+}
+
+// SatOutHand counts a hand the player sat out, and sits the player back in once all hands are sat out
+func (p *Player) SatOutHand() {
+	if !p.SittingOut() || p.seat.sitOutHands == 0 {
+		return
+	}
+
+	p.seat.sitOutHands--
+	if p.seat.sitOutHands == 0 {
+		p.SitIn()
+	}
+}
+
+// MissBlind adds a blind that passed the player while sitting out
+func (p *Player) MissBlind(blind int64) {
+	p.seat.missedBlinds += blind
+}
+
+// MissedBlinds returns the blinds the player owes
+func (p *Player) MissedBlinds() int64 {
+	return p.seat.missedBlinds
+}
+
+// ClearMissedBlinds clears the blinds the player owes, once they are posted
+func (p *Player) ClearMissedBlinds() {
+	p.seat.missedBlinds = 0
+}
+
+// Disconnect marks the player as disconnected, while the seat is held for them
+func (p *Player) Disconnect(at time.Time) {
+	p.seat.disconnectedAt = at
+}
+
+// Reconnect marks the player as connected again
+func (p *Player) Reconnect() {
+	p.seat.disconnectedAt = time.Time{}
+}
+
+// Disconnected returns true if the player's seat is held for them to reconnect
+func (p *Player) Disconnected() bool {
+	return !p.seat.disconnectedAt.IsZero()
+}
+
+// DisconnectedAt returns the time the player disconnected
+func (p *Player) DisconnectedAt() time.Time {
+	return p.seat.disconnectedAt
+}
+
+// ResetSeat resets the seat when the player leaves the table
+func (p *Player) ResetSeat() {
+	p.seat = seat{}
+}
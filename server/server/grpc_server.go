@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"time"
 
 	gocloak "github.com/Nerzal/gocloak/v7"
 	"github.com/fatih/color"
@@ -24,6 +25,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/DanTulovsky/logger"
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
@@ -139,14 +141,11 @@ func (ps *pokerServer) Register(ctx context.Context, in *ppb.RegisterRequest) (*
 	cinfo := in.GetClientInfo()
 	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
 
-	resultc := make(chan actions.PlayerActionResult)
+	resultc := make(chan actions.PlayerActionResult, 1)
 	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionRegister, nil, in.GetClientInfo(), nil, resultc)
 
-	// Send request to manager
-	ps.managerChan <- action
-
-	// block on response
-	res := <-resultc
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		if errors.Is(res.Err, actions.ErrUserExists) {
 			return nil, status.Errorf(codes.AlreadyExists, "%v", res.Err)
@@ -172,15 +171,22 @@ func (ps *pokerServer) JoinTable(ctx context.Context, in *ppb.JoinTableRequest)
 
 	cinfo := in.GetClientInfo()
 	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+	if cinfo.TableID == "" {
+		cinfo.TableID = in.GetTableID()
+	}
 
-	resultc := make(chan actions.PlayerActionResult)
-	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionJoinTable, nil, in.GetClientInfo(), nil, resultc)
+	opts := &ppb.ActionOpts{
+		TablePassword: in.GetPassword(),
+		BuyinAmount:   in.GetBuyinAmount(),
+		AutoRebuy:     in.GetAutoRebuy(),
+		Seat:          in.GetSeat(),
+	}
 
-	// Send request to manager
-	ps.managerChan <- action
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionJoinTable, opts, cinfo, nil, resultc)
 
-	// block on response
-	res := <-resultc
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		return nil, fmt.Errorf("invalid request: %v", res.Err)
 	}
@@ -204,14 +210,11 @@ func (ps *pokerServer) TakeTurn(ctx context.Context, in *ppb.TakeTurnRequest) (*
 	cinfo := in.GetClientInfo()
 	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
 
-	resultc := make(chan actions.PlayerActionResult)
+	resultc := make(chan actions.PlayerActionResult, 1)
 	action := actions.NewPlayerAction(ctx, in.GetPlayerAction(), in.GetActionOpts(), in.GetClientInfo(), nil, resultc)
 
-	// Send request to manager
-	ps.managerChan <- action
-
-	// block on response
-	res := <-resultc
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		return nil, fmt.Errorf("invalid request: %v", res.Err)
 	}
@@ -233,17 +236,14 @@ func (ps *pokerServer) AckToken(ctx context.Context, in *ppb.AckTokenRequest) (*
 	cinfo := in.GetClientInfo()
 	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
 
-	resultc := make(chan actions.PlayerActionResult)
+	resultc := make(chan actions.PlayerActionResult, 1)
 	opts := &ppb.ActionOpts{
 		AckToken: in.GetToken(),
 	}
 	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionAckToken, opts, in.GetClientInfo(), nil, resultc)
 
-	// Send request to manager
-	ps.managerChan <- action
-
-	// block on response
-	res := <-resultc
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		return nil, fmt.Errorf("invalid request: %v", res.Err)
 	}
@@ -264,14 +264,11 @@ func (ps *pokerServer) Play(in *ppb.PlayRequest, stream ppb.PokerServer_PlayServ
 	// it is read in the goroutine started below
 	toPlayerC := make(chan actions.GameData)
 
-	resultc := make(chan actions.PlayerActionResult)
+	resultc := make(chan actions.PlayerActionResult, 1)
 	action := actions.NewPlayerAction(stream.Context(), ppb.PlayerAction_PlayerActionPlay, nil, in.GetClientInfo(), toPlayerC, resultc)
 
-	// Send request to manager
-	ps.managerChan <- action
-
 	// block on response, an error here means we failed to subscribe and should exit
-	res := <-resultc
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		return fmt.Errorf("invalid request: %v", res.Err)
 	}
@@ -319,11 +316,16 @@ OUTER:
 			ps.l.Debugf("Sent data to client (%v): %#v", in.ClientInfo.PlayerUsername, input.Data.WaitTurnID)
 
 			span.Finish()
+		case <-ctx.Done():
+			// the client went away, hold the seat now rather than on the next failed send
+			ps.l.Infof("client connection to %v lost: %v", in.ClientInfo.PlayerUsername, ctx.Err())
+			err = ctx.Err()
+			break OUTER
 		}
 	}
 
 	// Return any player.Stack() to player.Bank()
-	if err := ps.playerDisconnected(stream.Context(), cinfo); err != nil {
+	if err := ps.playerDisconnected(stream.Context(), cinfo, toPlayerC); err != nil {
 		ps.l.Error(err)
 	}
 
@@ -331,21 +333,224 @@ OUTER:
 	return err
 }
 
-func (ps *pokerServer) playerDisconnected(ctx context.Context, cinfo *ppb.ClientInfo) error {
+// CreateTable creates and starts a new cash table
+func (ps *pokerServer) CreateTable(ctx context.Context, in *ppb.CreateTableRequest) (*ppb.CreateTableResponse, error) {
+	ps.l.Info("Received CreateTable RPC")
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewCreateTableAction(ctx, cinfo, in.GetConfig(), resultc)
+
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
+	if res.Err != nil {
+		return nil, status.Errorf(codes.Unknown, "invalid request: %v", res.Err)
+	}
+
+	return res.Result.(*ppb.CreateTableResponse), nil
+}
+
+// UpdateInvites invites players to, and revokes invites from, a private table created by the player
+func (ps *pokerServer) UpdateInvites(ctx context.Context, in *ppb.UpdateInvitesRequest) (*ppb.UpdateInvitesResponse, error) {
+	ps.l.Info("Received UpdateInvites RPC")
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+	cinfo.TableID = in.GetTableID()
+
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewUpdateInvitesAction(ctx, cinfo, in.GetInvite(), in.GetRevoke(), resultc)
+
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
+	if res.Err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid request: %v", res.Err)
+	}
+
+	return res.Result.(*ppb.UpdateInvitesResponse), nil
+}
+
+// ListTables lists the tables matching the filter
+func (ps *pokerServer) ListTables(ctx context.Context, in *ppb.ListTablesRequest) (*ppb.ListTablesResponse, error) {
+	ps.l.Info("Received ListTables RPC")
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+
+	return ps.listTables(ctx, cinfo, in.GetFilter())
+}
+
+// WatchLobby is a server streaming RPC that sends the tables matching the filter whenever they change
+func (ps *pokerServer) WatchLobby(in *ppb.ListTablesRequest, stream ppb.PokerServer_WatchLobbyServer) error {
+	ps.l.Info("Received WatchLobby RPC")
+	ctx := stream.Context()
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+
+	ticker := time.NewTicker(*lobbyUpdateInterval)
+	defer ticker.Stop()
+
+	var last *ppb.ListTablesResponse
+	for {
+		res, err := ps.listTables(ctx, cinfo, in.GetFilter())
+		if err != nil {
+			return err
+		}
+
+		if last == nil || !proto.Equal(res, last) {
+			if err := stream.Send(res); err != nil {
+				ps.l.Infof("lobby connection to %v lost: %v", cinfo.PlayerUsername, err)
+				return err
+			}
+			last = res
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// listTables asks the manager for the tables matching the filter
+func (ps *pokerServer) listTables(ctx context.Context, cinfo *ppb.ClientInfo, filter *ppb.TableFilter) (*ppb.ListTablesResponse, error) {
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewListTablesAction(ctx, cinfo, filter, resultc)
+
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
+	if res.Err != nil {
+		return nil, status.Errorf(codes.Unknown, "invalid request: %v", res.Err)
+	}
+
+	return res.Result.(*ppb.ListTablesResponse), nil
+}
+
+// Watch is a server streaming RPC that sends the public table info to a spectator
+func (ps *pokerServer) Watch(in *ppb.WatchRequest, stream ppb.PokerServer_WatchServer) error {
+	ps.l.Info("Received Watch RPC")
+	ctx := stream.Context()
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+	cinfo.TableID = in.GetTableID()
+
+	delay := time.Duration(in.GetDelaySec()) * time.Second
+	if delay < *watchDelay {
+		delay = *watchDelay
+	}
+
+	toWatcherC := make(chan *ppb.GameInfo)
+
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewWatchAction(ctx, cinfo, delay, toWatcherC, resultc)
+	action.Opts = &ppb.ActionOpts{TablePassword: in.GetPassword()}
+
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
+	if res.Err != nil {
+		return status.Errorf(codes.Unknown, "invalid request: %v", res.Err)
+	}
+
+	for {
+		select {
+		case info, ok := <-toWatcherC:
+			if !ok {
+				ps.l.Infof("[%v] table closed, spectator stream ending", cinfo.PlayerUsername)
+				return nil
+			}
+			if err := stream.Send(info); err != nil {
+				ps.l.Infof("spectator connection to %v lost: %v", cinfo.PlayerUsername, err)
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// JoinWaitlist is a server streaming RPC that queues the player for a seat, and sends the place in the queue and seat offers
+// The player leaves the waitlist by ending the stream.
+func (ps *pokerServer) JoinWaitlist(in *ppb.JoinWaitlistRequest, stream ppb.PokerServer_JoinWaitlistServer) error {
+	ps.l.Info("Received JoinWaitlist RPC")
+	ctx := stream.Context()
+
+	cinfo := in.GetClientInfo()
+	if cinfo == nil {
+		cinfo = &ppb.ClientInfo{}
+	}
+	cinfo.PlayerUsername = *ctx.Value(auth.UinfoType("uinfo")).(*gocloak.UserInfo).PreferredUsername
+	cinfo.TableID = in.GetTableID()
+
+	opts := &ppb.ActionOpts{
+		TablePassword: in.GetPassword(),
+		BuyinAmount:   in.GetBuyinAmount(),
+	}
+
+	// buffered, the manager drops updates instead of blocking on a slow client
+	toWaitlistC := make(chan *ppb.WaitlistUpdate, 16)
+
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewJoinWaitlistAction(ctx, cinfo, in.GetFilter(), opts, toWaitlistC, resultc)
+
+	// Send request to manager and block on response
+	res := ps.send(ctx, action)
+	if res.Err != nil {
+		return status.Errorf(codes.Unknown, "invalid request: %v", res.Err)
+	}
+
+	for {
+		select {
+		case u, ok := <-toWaitlistC:
+			if !ok {
+				ps.l.Infof("[%v] waitlist stream ending", cinfo.PlayerUsername)
+				return nil
+			}
+			if err := stream.Send(u); err != nil {
+				ps.l.Infof("waitlist connection to %v lost: %v", cinfo.PlayerUsername, err)
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ps *pokerServer) playerDisconnected(ctx context.Context, cinfo *ppb.ClientInfo, toPlayerC chan actions.GameData) error {
 
 	span, _ := opentracing.StartSpanFromContext(ctx, "playerDisconnected")
 	span.SetTag("playerUsername", cinfo.PlayerUsername)
 	ext.Component.Set(span, "grpc_server")
 	defer span.Finish()
 
-	resultc := make(chan actions.PlayerActionResult)
-	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionDisconnect, nil, cinfo, nil, resultc)
+	// the stream context is already done, the table still needs to hear about it
+	ctx, cancel := context.WithTimeout(opentracing.ContextWithSpan(context.Background(), span), *disconnectTimeout)
+	defer cancel()
 
-	// Send request to manager
-	ps.managerChan <- action
+	resultc := make(chan actions.PlayerActionResult, 1)
+	action := actions.NewPlayerAction(ctx, ppb.PlayerAction_PlayerActionDisconnect, nil, cinfo, toPlayerC, resultc)
 
 	// block on response, an error here means we failed to subscribe and should exit
-	res := <-resultc
+	res := ps.send(ctx, action)
 	if res.Err != nil {
 		ext.Error.Set(span, true)
 		span.LogFields(log.String("error", res.Err.Error()))
@@ -354,3 +559,19 @@ func (ps *pokerServer) playerDisconnected(ctx context.Context, cinfo *ppb.Client
 
 	return nil
 }
+
+// send sends the action to the manager and waits for the result, until the rpc is done
+func (ps *pokerServer) send(ctx context.Context, action actions.PlayerAction) actions.PlayerActionResult {
+	select {
+	case ps.managerChan <- action:
+	case <-ctx.Done():
+		return actions.NewPlayerActionError(ctx.Err())
+	}
+
+	select {
+	case res := <-action.ResultC:
+		return res
+	case <-ctx.Done():
+		return actions.NewPlayerActionError(ctx.Err())
+	}
+}
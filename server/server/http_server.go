@@ -7,9 +7,13 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"path"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
+
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
 )
 
 var (
@@ -68,3 +72,39 @@ func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	tmpl.Execute(w, data)
 }
+
+// AuditHandler serves audit queries of the chip ledger as text, one transaction per line, on the pprof listener
+// The query parameters are account (e.g. "bank:<player id>"), table (a table id) and since (RFC 3339).
+type AuditHandler struct {
+	Ledger *ledger.Ledger
+}
+
+func (h *AuditHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var q ledger.Query
+
+	if s := r.FormValue("account"); s != "" {
+		a, err := ledger.ParseAccount(s)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		q.Account = &a
+	}
+	q.TableID = id.TableID(r.FormValue("table"))
+	if s := r.FormValue("since"); s != "" {
+		since, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid since: %v", err), http.StatusBadRequest)
+			return
+		}
+		q.Since = since
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if q.Account != nil {
+		fmt.Fprintf(w, "balance of %v: %v\n", q.Account, h.Ledger.Balance(*q.Account))
+	}
+	for _, tx := range h.Ledger.Audit(q) {
+		fmt.Fprintf(w, "%v %v\n", tx.Time.Format(time.RFC3339), tx)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/fullstorydev/grpcui/standalone"
@@ -21,11 +22,12 @@ import (
 	"github.com/DanTulovsky/logger"
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
 	"github.com/DanTulovsky/pepper-poker-v2/auth"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
 )
 
 var (
 	httpPort         = flag.String("http_port", "8081", "port to listen on")
-	pprofPort        = flag.String("pprof_port", "6060", "port for pprof")
+	pprofPort        = flag.String("pprof_port", "6060", "port for pprof and the ledger audit (/audit), served on localhost only")
 	secureGRPCPort   = flag.String("secure_grpc_port", "8443", "port to listen on for secure grpc")
 	insecureGRPCPort = flag.String("insecure_grpc_port", "8082", "port to listen on for insecure grpc")
 	grpcUIPort       = flag.String("grpc_ui_port", "8080", "port for serving grpc ui")
@@ -33,6 +35,12 @@ var (
 
 	grpcCrt = flag.String("grpc_crt", "/Users/dant/go/src/github.com/DanTulovsky/pepper-poker-v2/cert/server.crt", "file containg certificate")
 	grpcKey = flag.String("grpc_key", "/Users/dant/go/src/github.com/DanTulovsky/pepper-poker-v2/key/server.key", "file containing key")
+
+	watchDelay = flag.Duration("watch_delay", 0, "minimum delay of the table updates streamed to spectators")
+
+	lobbyUpdateInterval = flag.Duration("lobby_update_interval", time.Second, "how often lobby watchers are checked for table changes")
+
+	disconnectTimeout = flag.Duration("disconnect_timeout", time.Second*5, "time to wait for the table to hear about a player whose stream ended")
 )
 
 // Server is the poker server
@@ -129,6 +137,9 @@ func (s *Server) startPprof() error {
 	pprofMux := http.DefaultServeMux
 	http.DefaultServeMux = http.NewServeMux()
 
+	// the audit shows every player's bank and transactions, it is only served on the local pprof listener
+	pprofMux.Handle("/audit", &AuditHandler{Ledger: table.Ledger()})
+
 	log.Println(http.ListenAndServe(fmt.Sprintf("localhost:%s", *pprofPort), pprofMux))
 
 	return nil
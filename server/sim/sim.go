@@ -0,0 +1,324 @@
+package sim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	pactions "github.com/DanTulovsky/pepper-poker-v2/pokerclient/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// number of transactions kept by the ledger of a simulation
+const simLedgerSize = 1000
+
+// DeciderFunc decides what a bot does on its turn, the same as roboclient.DeciderFunc
+type DeciderFunc func(data *ppb.GameData) (*pactions.PlayerAction, error)
+
+// Bot is a simulated player
+type Bot struct {
+	Name    string
+	Decider DeciderFunc
+	// Bank is the money the bot starts with, it buys in for the table maximum whenever its stack is empty
+	Bank int64
+}
+
+// Config configures a simulation
+type Config struct {
+	// Table is the table played at, its clock is replaced by a fake one
+	// The chips move in the table's ledger, a simulation gets a ledger of its own if none is set.
+	Table table.TableConfig
+	Bots  []Bot
+	// Hands is the number of hands played, fewer if only one bot has money left
+	Hands int
+	// Seed seeds the shuffles, the same seed and bots play the same hands
+	Seed int64
+	// Step is how far the clock moves on every table tick, the table timers run on it
+	Step time.Duration
+	// MaxTicks stops a simulation that no longer finishes hands
+	MaxTicks int
+}
+
+// DefaultConfig returns a config for a thousand hands at the default table
+func DefaultConfig() Config {
+	return Config{
+		Table:    table.DefaultTableConfig(),
+		Hands:    1000,
+		Seed:     1,
+		Step:     time.Second,
+		MaxTicks: 1000,
+	}
+}
+
+// BotResult is how a bot did in the simulation
+type BotResult struct {
+	Name     string
+	PlayerID id.PlayerID
+
+	// Bank and Stack at the end of the simulation, Net is the money won or lost
+	Bank, Stack int64
+	Net         int64
+
+	HandsPlayed int
+	HandsWon    int
+	// Errors counts the decisions that failed or were rejected by the table
+	Errors int
+}
+
+// Result is the outcome of a simulation
+type Result struct {
+	Bots []BotResult
+	// Hands are the hand histories, in the order they were played
+	Hands []*handhistory.Hand
+}
+
+// bot is a bot seated at the simulated table
+type bot struct {
+	Bot
+	p  *player.Player
+	cc chan actions.GameData
+
+	data     *ppb.GameData
+	lastTurn int64
+	lastAck  string
+	errors   int
+}
+
+// Run plays the hands at a table in-process, with no network and no real time passing
+// The table is ticked on the calling goroutine; a hand whose chips do not add up in the ledger fails the simulation.
+func Run(c Config) (*Result, error) {
+	if len(c.Bots) < 2 {
+		return nil, fmt.Errorf("a simulation needs at least two bots, have %d", len(c.Bots))
+	}
+	if c.Step <= 0 {
+		return nil, fmt.Errorf("step must be positive, have %v", c.Step)
+	}
+
+	clk := clock.NewFake(time.Unix(0, 0))
+	tc := c.Table
+	tc.Clock = clk
+	tc.Seed = c.Seed
+	if tc.Ledger == nil {
+		tc.Ledger = ledger.New(simLedgerSize)
+	}
+	if err := tc.Validate(); err != nil {
+		return nil, err
+	}
+
+	t := table.New(make(chan table.ActionRequest), tc)
+
+	var bots []*bot
+	for _, b := range c.Bots {
+		sb, err := seat(t, tc.Ledger, b)
+		if err != nil {
+			return nil, err
+		}
+		bots = append(bots, sb)
+	}
+
+	res := &Result{}
+	var lastHand int64
+	ticks := 0
+
+	for len(res.Hands) < c.Hands && playing(bots) > 1 {
+		clk.Advance(c.Step)
+		if err := t.Tick(); err != nil {
+			return res, err
+		}
+
+		for _, h := range t.HandHistory().Hands() {
+			if h.Number > lastHand {
+				lastHand = h.Number
+				res.Hands = append(res.Hands, h)
+				ticks = 0
+			}
+		}
+
+		for _, b := range bots {
+			b.play(t)
+		}
+
+		if ticks++; c.MaxTicks > 0 && ticks > c.MaxTicks {
+			return res, fmt.Errorf("no hand finished in %d ticks (state: %v)", c.MaxTicks, t.State.Name())
+		}
+	}
+
+	for _, b := range bots {
+		res.Bots = append(res.Bots, b.result(res.Hands))
+	}
+
+	return res, audit(t, tc.Ledger, bots)
+}
+
+// seat adds the bot to the table with an opening balance in the table's ledger
+func seat(t *table.Table, l *ledger.Ledger, b Bot) (*bot, error) {
+	p := player.New(users.User{Username: b.Name, Name: b.Name, Bank: b.Bank})
+	if err := l.Transfer(id.EmptyTableID, ledger.External(), ledger.Bank(p.ID), b.Bank, "opening balance"); err != nil {
+		return nil, err
+	}
+
+	if res := t.Do(actions.ActionAddPlayer, p, table.AddPlayerOpts{Seat: -1}); res.Err != nil {
+		return nil, fmt.Errorf("unable to seat [%v]: %v", b.Name, res.Err)
+	}
+
+	sb := &bot{
+		Bot:      b,
+		p:        p,
+		cc:       make(chan actions.GameData, 1),
+		lastTurn: -1,
+	}
+	if res := t.Do(actions.ActionRegisterPlayerCC, p, sb.cc); res.Err != nil {
+		return nil, res.Err
+	}
+	return sb, nil
+}
+
+// playing returns the number of bots with money left to play
+func playing(bots []*bot) int {
+	var n int
+	for _, b := range bots {
+		if b.p.Money().Stack()+b.p.Money().Bank() > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// play acks the tokens, buys in again and takes the turn of the bot, like a client does with the game data
+func (b *bot) play(t *table.Table) {
+	for {
+		select {
+		case in := <-b.cc:
+			b.data = in.Data
+			continue
+		default:
+		}
+		break
+	}
+	if b.data == nil {
+		return
+	}
+
+	if tok := b.data.GetInfo().GetAckToken(); tok != "" && tok != b.lastAck {
+		b.lastAck = tok
+		t.Do(actions.ActionAckToken, b.p, tok)
+	}
+
+	states := b.data.GetPlayer().GetState()
+	switch {
+	case hasState(states, ppb.PlayerState_PlayerStateStackEmpty):
+		if b.data.GetInfo().GetGameState() <= ppb.GameState_GameStateWaitingPlayers && b.p.Money().Bank() > 0 {
+			if res := t.Do(actions.ActionBuyIn, b.p, int64(0)); res.Err != nil {
+				b.errors++
+			}
+			b.data = nil
+		}
+
+	case hasState(states, ppb.PlayerState_PlayerStateCurrentTurn) && b.data.GetWaitTurnNum() > b.lastTurn:
+		b.lastTurn = b.data.GetWaitTurnNum()
+		if err := b.takeTurn(t); err != nil {
+			b.errors++
+		}
+	}
+}
+
+// takeTurn asks the decider what to do and does it, the table acts for the bot once its turn times out otherwise
+func (b *bot) takeTurn(t *table.Table) error {
+	pa, err := b.Decider(b.data)
+	if err != nil {
+		return err
+	}
+	if pa == nil {
+		return fmt.Errorf("[%v] decided on nothing", b.Name)
+	}
+
+	var a actions.TableAction
+	var opts interface{}
+
+	switch pa.Action {
+	case ppb.PlayerAction_PlayerActionCheck:
+		a = actions.ActionCheck
+	case ppb.PlayerAction_PlayerActionCall:
+		a = actions.ActionCall
+	case ppb.PlayerAction_PlayerActionFold:
+		a = actions.ActionFold
+	case ppb.PlayerAction_PlayerActionAllIn:
+		a = actions.ActionAllIn
+	case ppb.PlayerAction_PlayerActionBet:
+		a = actions.ActionBet
+		opts = pa.Opts.GetBetAmount()
+	default:
+		return fmt.Errorf("[%v] decided on an action a bot cannot take: %v", b.Name, pa.Action)
+	}
+
+	return t.Do(a, b.p, opts).Err
+}
+
+// result returns how the bot did in the hands
+func (b *bot) result(hands []*handhistory.Hand) BotResult {
+	r := BotResult{
+		Name:     b.Name,
+		PlayerID: b.p.ID,
+		Bank:     b.p.Money().Bank(),
+		Stack:    b.p.Money().Stack(),
+		Errors:   b.errors,
+	}
+	r.Net = r.Bank + r.Stack - b.Bot.Bank
+
+	for _, h := range hands {
+		if _, ok := h.Seat(b.p.ID); !ok {
+			continue
+		}
+		r.HandsPlayed++
+
+		for _, e := range h.EventsOf(handhistory.EventCollect) {
+			if e.PlayerID == b.p.ID {
+				r.HandsWon++
+				break
+			}
+		}
+	}
+	return r
+}
+
+// audit checks the table's ledger against the bots, and that no money was created or lost at the table
+func audit(t *table.Table, l *ledger.Ledger, bots []*bot) error {
+	expected := map[ledger.Account]int64{
+		ledger.Pot(t.ID): 0,
+	}
+
+	var start, end int64
+	for _, b := range bots {
+		expected[ledger.Bank(b.p.ID)] = b.p.Money().Bank()
+		expected[ledger.Stack(b.p.ID)] = b.p.Money().Stack()
+
+		start += b.Bot.Bank
+		end += b.p.Money().Bank() + b.p.Money().Stack()
+	}
+	if err := l.Verify(expected); err != nil {
+		return err
+	}
+
+	if rake := l.Balance(ledger.Rake(t.ID)); start != end+rake {
+		return fmt.Errorf("the bots started with %v, and ended with %v (rake: %v)", start, end, rake)
+	}
+	return nil
+}
+
+// hasState returns true if the player is in the state
+func hasState(states []ppb.PlayerState, s ppb.PlayerState) bool {
+	for _, state := range states {
+		if state == s {
+			return true
+		}
+	}
+	return false
+}
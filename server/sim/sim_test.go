@@ -0,0 +1,164 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/pokerclient/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/server/table"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func allowed(data *ppb.GameData, a ppb.PlayerAction) bool {
+	for _, aa := range data.GetAllowedActions() {
+		if aa == a {
+			return true
+		}
+	}
+	return false
+}
+
+// checker checks or calls, like robo_checker
+func checker(data *ppb.GameData) (*actions.PlayerAction, error) {
+	a := ppb.PlayerAction_PlayerActionCall
+	if allowed(data, ppb.PlayerAction_PlayerActionCheck) {
+		a = ppb.PlayerAction_PlayerActionCheck
+	}
+	return actions.NewPlayerAction(id.EmptyPlayerID, id.EmptyTableID, a, nil, nil), nil
+}
+
+// raiser bets the minimum raise whenever it can
+func raiser(data *ppb.GameData) (*actions.PlayerAction, error) {
+	if allowed(data, ppb.PlayerAction_PlayerActionBet) && data.GetMinBet() <= data.GetMaxBet() {
+		opts := &ppb.ActionOpts{BetAmount: data.GetMinBet()}
+		return actions.NewPlayerAction(id.EmptyPlayerID, id.EmptyTableID, ppb.PlayerAction_PlayerActionBet, opts, nil), nil
+	}
+	return checker(data)
+}
+
+// checkMinRaises checks that every bet and raise of the player, other than an all in, is the smallest one allowed
+// It returns the number of bets and raises checked.
+func checkMinRaises(t *testing.T, h *handhistory.Hand, p id.PlayerID) int {
+	var checked int
+	var currentBet, lastRaise int64
+
+	for _, e := range h.Events {
+		switch e.Type {
+		case handhistory.EventStreet:
+			currentBet, lastRaise = 0, 0
+		case handhistory.EventSmallBlind, handhistory.EventBigBlind, handhistory.EventStraddle, handhistory.EventBet, handhistory.EventRaise:
+			if e.RaiseTo <= currentBet {
+				continue
+			}
+			raise := e.RaiseTo - currentBet
+
+			if (e.Type == handhistory.EventBet || e.Type == handhistory.EventRaise) && e.PlayerID == p && !e.AllIn {
+				checked++
+				want := lastRaise
+				if want < h.BigBlind {
+					want = h.BigBlind
+				}
+				if raise != want {
+					t.Errorf("hand %d: raised by %v to %v, want the minimum raise of %v", h.Number, raise, e.RaiseTo, want)
+				}
+			}
+
+			switch {
+			case e.Type == handhistory.EventBigBlind:
+				// the big blind is a full bet
+				lastRaise = h.BigBlind
+			case e.Type == handhistory.EventStraddle:
+				// the straddle is a raise of its full size
+				lastRaise = e.RaiseTo
+			case raise >= lastRaise:
+				lastRaise = raise
+			}
+			currentBet = e.RaiseTo
+		}
+	}
+	return checked
+}
+
+func TestRun(t *testing.T) {
+	run := func(seed int64) *Result {
+		c := DefaultConfig()
+		c.Hands = 30
+		c.Seed = seed
+		c.Bots = []Bot{
+			{Name: "checker", Decider: checker, Bank: c.Table.MaxBuyin * c.Table.BigBlind * 3},
+			{Name: "raiser", Decider: raiser, Bank: c.Table.MaxBuyin * c.Table.BigBlind * 3},
+			{Name: "caller", Decider: checker, Bank: c.Table.MaxBuyin * c.Table.BigBlind * 3},
+		}
+
+		res, err := Run(c)
+		if err != nil {
+			t.Fatalf("Run() = %v", err)
+		}
+		return res
+	}
+
+	res := run(7)
+	if len(res.Hands) != 30 {
+		t.Errorf("played %d hands, want 30", len(res.Hands))
+	}
+
+	var net int64
+	for _, b := range res.Bots {
+		net += b.Net
+		if b.Errors > 0 {
+			t.Errorf("[%v] made %d bad decisions", b.Name, b.Errors)
+		}
+	}
+	if net > 0 {
+		t.Errorf("the bots won %v between them", net)
+	}
+
+	// the raiser's bets are sent in betAmount units, including from the blinds
+	var raises int
+	for _, h := range res.Hands {
+		raises += checkMinRaises(t, h, res.Bots[1].PlayerID)
+	}
+	if raises == 0 {
+		t.Errorf("the raiser never raised")
+	}
+
+	again := run(7)
+	for i, b := range res.Bots {
+		if again.Bots[i].Net != b.Net {
+			t.Errorf("[%v] net with the same seed = %v, want %v", b.Name, again.Bots[i].Net, b.Net)
+		}
+	}
+}
+
+func TestRun_ledger(t *testing.T) {
+	config := func() Config {
+		c := DefaultConfig()
+		c.Hands = 5
+		c.Bots = []Bot{
+			{Name: "checker", Decider: checker, Bank: c.Table.MaxBuyin * c.Table.BigBlind},
+			{Name: "raiser", Decider: raiser, Bank: c.Table.MaxBuyin * c.Table.BigBlind},
+		}
+		return c
+	}
+
+	shared := len(table.Ledger().Audit(ledger.Query{}))
+	if _, err := Run(config()); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if got := len(table.Ledger().Audit(ledger.Query{})); got != shared {
+		t.Errorf("the simulation recorded %d transactions in the shared ledger, want none", got-shared)
+	}
+
+	// the bots are audited in the ledger the caller passes
+	c := config()
+	c.Table.Ledger = ledger.New(simLedgerSize)
+	if _, err := Run(c); err != nil {
+		t.Fatalf("Run() with a ledger = %v", err)
+	}
+	if len(c.Table.Ledger.Audit(ledger.Query{})) == 0 {
+		t.Errorf("the simulation recorded nothing in the ledger it was passed")
+	}
+}
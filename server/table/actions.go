@@ -5,6 +5,14 @@ import (
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 )
 
+// AddPlayerOpts are the options of an AddPlayer action
+type AddPlayerOpts struct {
+	// Buyin is the amount to buy in for, 0 for the table maximum
+	Buyin int64
+	// Seat is the position the player wants, any open seat if < 0
+	Seat int
+}
+
 // ActionAddPlayerResult is the result of an AddPlayer action
 type ActionAddPlayerResult struct {
 	Position int
@@ -15,6 +23,13 @@ type ActionInfoResult struct {
 	AvailableToJoin        bool
 	Name                   string
 	MaxPlayers, MinPlayers int
+	// players seated at the table
+	Players int
+	// private tables are only joined by ID
+	Private bool
+
+	// players at a tournament table that are still in the tournament
+	TournamentPlayers int
 }
 
 // ActionRequest is sent to the table
@@ -0,0 +1,165 @@
+package table
+
+import (
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+const (
+	// defaultRaiseCap is the number of bets and raises allowed per betting round in fixed-limit games
+	defaultRaiseCap = 4
+)
+
+// BetContext is the table state needed to compute the legal bets for a player
+type BetContext struct {
+	// the current betting round
+	Street ppb.GameState
+
+	BigBlind int64
+	// Pot is the total in the pot, including the bets made this round
+	Pot int64
+	// CurrentBet is the highest total bet made by any player this round
+	CurrentBet int64
+	// LastRaise is the size of the last full bet or raise this round
+	LastRaise int64
+	// Raises is the number of bets and raises made this round
+	Raises int
+
+	// BetThisRound is what the acting player already put in this round
+	BetThisRound int64
+	// Stack is the acting player's stack
+	Stack int64
+}
+
+// toCall returns the amount the player must put in to call, ignoring their stack
+func (c BetContext) toCall() int64 {
+	return c.CurrentBet - c.BetThisRound
+}
+
+// BetLimits are the legal amounts a player can put in with their next action
+// All amounts are in addition to what the player already bet this round
+type BetLimits struct {
+	// Call is the amount needed to call, capped at the player's stack
+	Call int64
+
+	// CanRaise is false if the player may only check, call or fold
+	CanRaise bool
+	// MinRaise is the smallest legal bet or raise, unless the player goes all in for less
+	MinRaise int64
+	// MaxRaise is the largest legal bet or raise
+	MaxRaise int64
+}
+
+// BettingStructure computes the legal bet and raise sizes for each action
+type BettingStructure interface {
+	Type() ppb.BettingStructure
+	Limits(c BetContext) BetLimits
+}
+
+// NewBettingStructure returns the BettingStructure for the given type
+func NewBettingStructure(s ppb.BettingStructure) BettingStructure {
+	switch s {
+	case ppb.BettingStructure_BettingStructurePotLimit:
+		return &potLimit{}
+	case ppb.BettingStructure_BettingStructureFixedLimit:
+		return &fixedLimit{raiseCap: defaultRaiseCap}
+	default:
+		return &noLimit{}
+	}
+}
+
+// DefaultBettingStructure returns the structure the variant is usually played with
+func DefaultBettingStructure(v ppb.GameVariant) ppb.BettingStructure {
+	switch v {
+	case ppb.GameVariant_GameVariantPotLimitOmaha:
+		return ppb.BettingStructure_BettingStructurePotLimit
+	default:
+		return ppb.BettingStructure_BettingStructureNoLimit
+	}
+}
+
+// callLimit returns the amount to call, capped at the stack
+func callLimit(c BetContext) int64 {
+	return min64(c.toCall(), c.Stack)
+}
+
+// minRaiseLimit returns the smallest legal raise under no-limit rules
+// A raise must be at least as large as the last full bet or raise, and never less than the big blind
+func minRaiseLimit(c BetContext) int64 {
+	raiseBy := c.LastRaise
+	if raiseBy < c.BigBlind {
+		raiseBy = c.BigBlind
+	}
+	return min64(c.CurrentBet+raiseBy-c.BetThisRound, c.Stack)
+}
+
+// noLimit allows any bet from the minimum raise up to the player's whole stack
+type noLimit struct{}
+
+func (s *noLimit) Type() ppb.BettingStructure {
+	return ppb.BettingStructure_BettingStructureNoLimit
+}
+
+func (s *noLimit) Limits(c BetContext) BetLimits {
+	return BetLimits{
+		Call:     callLimit(c),
+		CanRaise: c.Stack > c.toCall(),
+		MinRaise: minRaiseLimit(c),
+		MaxRaise: c.Stack,
+	}
+}
+
+// potLimit uses the no-limit minimum raise, but caps any bet at the pot size after calling
+type potLimit struct{}
+
+func (s *potLimit) Type() ppb.BettingStructure {
+	return ppb.BettingStructure_BettingStructurePotLimit
+}
+
+func (s *potLimit) Limits(c BetContext) BetLimits {
+	toCall := c.toCall()
+
+	return BetLimits{
+		Call:     callLimit(c),
+		CanRaise: c.Stack > toCall,
+		MinRaise: minRaiseLimit(c),
+		MaxRaise: min64(toCall+c.Pot+toCall, c.Stack),
+	}
+}
+
+// fixedLimit bets and raises by the small bet before the turn and the big bet after,
+// with at most raiseCap bets and raises per round
+type fixedLimit struct {
+	raiseCap int
+}
+
+func (s *fixedLimit) Type() ppb.BettingStructure {
+	return ppb.BettingStructure_BettingStructureFixedLimit
+}
+
+func (s *fixedLimit) Limits(c BetContext) BetLimits {
+	raise := min64(c.CurrentBet+s.betSize(c)-c.BetThisRound, c.Stack)
+
+	return BetLimits{
+		Call:     callLimit(c),
+		CanRaise: c.Stack > c.toCall() && c.Raises < s.raiseCap,
+		MinRaise: raise,
+		MaxRaise: raise,
+	}
+}
+
+// betSize returns the small bet (the big blind) on the first two rounds and the big bet on the last two
+func (s *fixedLimit) betSize(c BetContext) int64 {
+	switch c.Street {
+	case ppb.GameState_GameStatePlayingTurn, ppb.GameState_GameStatePlayingRiver:
+		return c.BigBlind * 2
+	default:
+		return c.BigBlind
+	}
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
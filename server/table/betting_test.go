@@ -0,0 +1,91 @@
+package table
+
+import (
+	"testing"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestBettingStructure_Limits(t *testing.T) {
+	tests := []struct {
+		name      string
+		structure ppb.BettingStructure
+		ctx       BetContext
+		want      BetLimits
+	}{
+		{
+			name:      "no limit open preflop",
+			structure: ppb.BettingStructure_BettingStructureNoLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingPreFlop, BigBlind: 10, Pot: 15,
+				CurrentBet: 10, LastRaise: 10, Raises: 1, Stack: 1000,
+			},
+			want: BetLimits{Call: 10, CanRaise: true, MinRaise: 20, MaxRaise: 1000},
+		},
+		{
+			name:      "no limit reraise must match the last raise",
+			structure: ppb.BettingStructure_BettingStructureNoLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingFlop, BigBlind: 10, Pot: 100,
+				CurrentBet: 50, LastRaise: 40, Raises: 2, BetThisRound: 10, Stack: 1000,
+			},
+			want: BetLimits{Call: 40, CanRaise: true, MinRaise: 80, MaxRaise: 1000},
+		},
+		{
+			name:      "no limit short stack can only go all in",
+			structure: ppb.BettingStructure_BettingStructureNoLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingFlop, BigBlind: 10, Pot: 100,
+				CurrentBet: 50, LastRaise: 50, Raises: 1, Stack: 30,
+			},
+			want: BetLimits{Call: 30, CanRaise: false, MinRaise: 30, MaxRaise: 30},
+		},
+		{
+			name:      "pot limit caps the raise at the pot after calling",
+			structure: ppb.BettingStructure_BettingStructurePotLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingPreFlop, BigBlind: 10, Pot: 15,
+				CurrentBet: 10, LastRaise: 10, Raises: 1, Stack: 1000,
+			},
+			want: BetLimits{Call: 10, CanRaise: true, MinRaise: 20, MaxRaise: 35},
+		},
+		{
+			name:      "fixed limit small bet on the flop",
+			structure: ppb.BettingStructure_BettingStructureFixedLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingFlop, BigBlind: 10, Pot: 40,
+				Stack: 1000,
+			},
+			want: BetLimits{Call: 0, CanRaise: true, MinRaise: 10, MaxRaise: 10},
+		},
+		{
+			name:      "fixed limit big bet on the river",
+			structure: ppb.BettingStructure_BettingStructureFixedLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingRiver, BigBlind: 10, Pot: 40,
+				CurrentBet: 20, LastRaise: 20, Raises: 1, Stack: 1000,
+			},
+			want: BetLimits{Call: 20, CanRaise: true, MinRaise: 40, MaxRaise: 40},
+		},
+		{
+			name:      "fixed limit raise cap",
+			structure: ppb.BettingStructure_BettingStructureFixedLimit,
+			ctx: BetContext{
+				Street: ppb.GameState_GameStatePlayingTurn, BigBlind: 10, Pot: 200,
+				CurrentBet: 80, LastRaise: 20, Raises: defaultRaiseCap, BetThisRound: 60, Stack: 1000,
+			},
+			want: BetLimits{Call: 20, CanRaise: false, MinRaise: 40, MaxRaise: 40},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewBettingStructure(tt.structure)
+			if s.Type() != tt.structure {
+				t.Errorf("Type() = %v, want %v", s.Type(), tt.structure)
+			}
+			if got := s.Limits(tt.ctx); got != tt.want {
+				t.Errorf("Limits() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,10 +4,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/DanTulovsky/deck"
 	"github.com/DanTulovsky/pepper-poker-v2/poker"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
-	"github.com/dustin/go-humanize"
 )
 
 type waitingPlayersState struct {
@@ -27,7 +25,7 @@ func (i *waitingPlayersState) Init() error {
 	i.table.pot = poker.NewPot()
 
 	i.l.Info("Shuffling the deck...")
-	i.table.deck = deck.NewShuffledDeck()
+	i.table.deck = i.table.shuffledDeck()
 
 	// reset any existing acks
 	i.table.clearAckToken()
@@ -51,14 +49,18 @@ func (i *waitingPlayersState) Tick() error {
 
 	i.l.Debugf("Tick(%v)", i.Name())
 
-	now := time.Now()
+	if i.table.tournamentOver() {
+		return i.table.setState(i.table.tournamentCompleteState)
+	}
+
+	now := i.table.clock.Now()
 	var status string
 
 	numAvailablePlayers := i.table.numAvailablePlayers()
 
 	status = fmt.Sprintf("Table [%v] waiting for players... (players: %d; %v)", i.table.Name, numAvailablePlayers, i.table.AvailablePlayers())
 
-	if numAvailablePlayers >= i.table.minPlayers && i.table.playersReady() {
+	if i.table.canStartHand() && i.table.playersReady() {
 		wait := i.gameWaitTimeout - now.Sub(i.lastPlayerAddedTime)
 		i.table.gameStartsInTime = wait
 
@@ -88,22 +90,26 @@ func (i *waitingPlayersState) Tick() error {
 }
 
 // AddPlayer adds the player to the table and returns the position at the table
-func (i *waitingPlayersState) AddPlayer(p *player.Player) (pos int, err error) {
-	i.lastPlayerAddedTime = time.Now()
+func (i *waitingPlayersState) AddPlayer(p *player.Player, opts AddPlayerOpts) (pos int, err error) {
+	i.lastPlayerAddedTime = i.table.clock.Now()
 
 	if i.table.numPresentPlayers() == i.table.maxPlayers {
 		return -1, fmt.Errorf("no available positions at table")
 	}
 
 	if !i.table.playerAtTable(p) {
+		pos, err := i.table.openSeat(opts.Seat)
+		if err != nil {
+			return -1, err
+		}
+
 		// buy in
-		i.l.Infof("[%v] buying into the table ($%v)", p.Name, humanize.Comma(i.table.buyinAmount))
-		if err := i.BuyIn(p); err != nil {
+		if err := i.BuyIn(p, opts.Buyin); err != nil {
 			return -1, err
 		}
 
 		i.l.Infof("Addting player [%v] to table [%v]", p.Name, i.table.Name)
-		i.table.positions[i.table.randomAvailablePosition()] = p
+		i.table.positions[pos] = p
 		p.TablePosition, err = i.table.PlayerPosition(p)
 		return i.table.PlayerPosition(p)
 	}
@@ -113,7 +119,7 @@ func (i *waitingPlayersState) AddPlayer(p *player.Player) (pos int, err error) {
 
 // Reset resets for next round
 func (i *waitingPlayersState) Reset() {
-	i.lastPlayerAddedTime = time.Now()
+	i.lastPlayerAddedTime = i.table.clock.Now()
 	i.initrun = false
 }
 
@@ -122,6 +128,6 @@ func (i *waitingPlayersState) WaitingTurnPlayer() *player.Player {
 }
 
 // BuyIn process the buyin request
-func (i *waitingPlayersState) BuyIn(p *player.Player) error {
-	return i.table.buyin(p)
+func (i *waitingPlayersState) BuyIn(p *player.Player, amount int64) error {
+	return i.table.buyin(p, amount)
 }
@@ -19,18 +19,20 @@ func (i *initializingState) Init() error {
 	i.l.Info("Initializing table...")
 
 	i.table.currentHand++
+	i.l.Infof("Hand %d (deck seed: %d)", i.table.currentHand, i.table.deckSeed)
+	i.table.tournamentStartHand()
 
-	i.table.buttonPosition = i.table.playerAfter(i.table.buttonPosition)
-	i.table.smallBlindPosition = i.table.playerAfter(i.table.buttonPosition)
-	i.table.bigBlindPosition = i.table.playerAfter(i.table.smallBlindPosition)
+	i.table.setBlindPositions()
 
-	i.table.smallBlindPlayer = i.table.positions[i.table.smallBlindPosition]
+	// the small blind is dead if its player is gone
+	i.table.smallBlindPlayer = i.table.handPlayerAt(i.table.smallBlindPosition)
 	i.table.bigBlindPlayer = i.table.positions[i.table.bigBlindPosition]
 
 	i.table.currentTurn = i.table.smallBlindPosition
+	i.table.chargeMissedBlinds()
 
-	i.l.Infof("button: %v", i.table.positions[i.table.buttonPosition].Name)
-	i.l.Infof("smallBlind: %v", i.table.smallBlindPlayer.Name)
+	i.l.Infof("button: %v", i.table.positions[i.table.buttonPosition])
+	i.l.Infof("smallBlind: %v", i.table.smallBlindPlayer)
 	i.l.Infof("bigBlind: %v", i.table.bigBlindPlayer.Name)
 
 	i.l.Info("Initializing player information for the hand...")
@@ -42,8 +44,13 @@ func (i *initializingState) Init() error {
 	i.table.ResetPlayersBets()
 
 	// Used to get an ack before game starts
-	i.token = acks.New(i.table.CurrentHandPlayers(), i.table.defaultAckTimeout)
+	i.token = acks.New(i.table.clock, i.table.CurrentHandPlayers(), i.table.defaultAckTimeout)
 	i.token.StartTimer()
+	for _, p := range i.table.CurrentHandPlayers() {
+		if p.Disconnected() {
+			i.token.Ack(p)
+		}
+	}
 	i.table.setAckToken(i.token)
 
 	i.initrun = true
@@ -71,6 +78,9 @@ func (i *initializingState) Tick() error {
 
 		for _, p := range failed {
 			i.l.Infof("removing disconnected player: %v", p.Name)
+			if i.table.tournament != nil {
+				i.table.tournamentPlayerLeft(p)
+			}
 			i.table.removePlayer(p)
 		}
 
@@ -27,7 +27,7 @@ func (i *readyToStartState) Init() error {
 	numPlayers.WithLabelValues("available").Set(float64(i.table.numAvailablePlayers()))
 
 	i.l.Info("Dealings cards to players...")
-	for j := 0; j < 2; j++ {
+	for j := 0; j < i.table.numHoleCards(); j++ {
 		for _, p := range i.table.CurrentHandPlayers() {
 			card, err := i.table.deck.Next()
 			if err != nil {
@@ -42,6 +42,8 @@ func (i *readyToStartState) Init() error {
 		i.l.Infof("  [%v ($%v)]: %v", p.Name, humanize.Comma(p.Money().Stack()), p.Hole())
 	}
 
+	i.table.startHandHistory()
+
 	i.initrun = true
 	return nil
 }
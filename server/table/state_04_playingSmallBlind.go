@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 )
 
@@ -14,14 +16,17 @@ type playingSmallBlindState struct {
 func (i *playingSmallBlindState) Init() error {
 	i.baseState.Init()
 
-	i.l.Infof("[%v] putting in small blind...", i.table.smallBlindPlayer.Name)
+	i.table.postAntes()
+	i.table.postMissedBlinds()
 
-	bet := i.table.smallBlind
-	if i.table.smallBlindPlayer.Money().Stack() < bet {
-		bet = i.table.smallBlindPlayer.Money().Stack()
-	}
-	if err := i.table.bet(i.table.smallBlindPlayer, bet, actions.ActionBet); err != nil {
-		i.l.Fatalf("playingSmallBlindState error: %s", err)
+	if sb := i.table.smallBlindPlayer; sb != nil {
+		i.l.Infof("[%v] putting in small blind...", sb.Name)
+
+		if err := i.table.postBlind(sb, i.table.smallBlind, actions.ActionPostSmallBlind, handhistory.EventSmallBlind); err != nil {
+			i.l.Fatalf("playingSmallBlindState error: %s", err)
+		}
+	} else {
+		i.l.Info("dead small blind, no one posts it")
 	}
 
 	i.table.advancePlayer()
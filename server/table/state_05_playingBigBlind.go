@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 )
 
@@ -16,15 +18,13 @@ func (i *playingBigBlindState) Init() error {
 
 	i.l.Infof("[%v] putting in big blind...", i.table.bigBlindPlayer.Name)
 
-	bet := i.table.bigBlind
-	if i.table.bigBlindPlayer.Money().Stack() < bet {
-		bet = i.table.bigBlindPlayer.Money().Stack()
-	}
-	if err := i.table.bet(i.table.bigBlindPlayer, bet, actions.ActionBet); err != nil {
+	if err := i.table.postBlind(i.table.bigBlindPlayer, i.table.bigBlind, actions.ActionPostBigBlind, handhistory.EventBigBlind); err != nil {
 		i.l.Fatalf("playingBigBlindState error: %s", err)
 	}
+	i.table.postBigBlindAnte()
 
 	i.table.advancePlayer()
+	i.table.postStraddle()
 
 	i.initrun = true
 	return nil
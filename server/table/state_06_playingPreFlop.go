@@ -1,7 +1,5 @@
 package table
 
-import "time"
-
 type playingPreFlopState struct {
 	baseState
 }
@@ -11,11 +9,19 @@ func (i *playingPreFlopState) Init() error {
 
 	i.table.SetPlayersActionRequired()
 
+	// the big blind counts as the opening bet of the round, and a straddle as a raise of its full size
+	i.table.raisesThisRound = 1
+	i.table.lastRaiseThisRound = i.table.bigBlind
+	if i.table.straddled {
+		i.table.raisesThisRound = 2
+		i.table.lastRaiseThisRound = i.table.straddleAmount()
+	}
+
 	// properly set from the previous state
 	p := i.table.positions[i.table.currentTurn]
 
 	i.l.Infof("Player %s (%d) goes first", p.Name, i.table.currentTurn)
-	p.WaitSince = time.Now()
+	p.WaitSince = i.table.clock.Now()
 
 	// records players that reached here
 	for _, p := range i.table.CurrentHandActivePlayers() {
@@ -47,7 +53,8 @@ func (i *playingPreFlopState) Tick() error {
 		return nil
 	}
 
-	i.table.FoldIfTurnTimerEnd(p)
+	i.table.applyPreAction(p)
+	i.table.ActIfTurnTimerEnd(p)
 
 	if !p.ActionRequired() {
 		i.table.advancePlayer()
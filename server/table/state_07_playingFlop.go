@@ -1,8 +1,6 @@
 package table
 
 import (
-	"time"
-
 	"github.com/DanTulovsky/deck"
 )
 
@@ -29,13 +27,14 @@ func (i *playingFlopState) Init() error {
 		i.table.board.AddCard(c)
 	}
 	i.l.Infof("Dealing the Flop... [%v]", i.table.board.Cards())
+	i.table.recordStreet()
 
 	// next available player after the button goes first
 	i.table.currentTurn = i.table.playerAfter(i.table.buttonPosition)
 
 	p := i.table.positions[i.table.currentTurn]
 	i.l.Infof("Player %s (%d) goes first", p.Name, i.table.currentTurn)
-	p.WaitSince = time.Now()
+	p.WaitSince = i.table.clock.Now()
 
 	// records players that reached here
 	for _, p := range i.table.CurrentHandActivePlayers() {
@@ -66,7 +65,8 @@ func (i *playingFlopState) Tick() error {
 	if p == nil {
 		return nil
 	}
-	i.table.FoldIfTurnTimerEnd(p)
+	i.table.applyPreAction(p)
+	i.table.ActIfTurnTimerEnd(p)
 
 	if !p.ActionRequired() {
 		i.table.advancePlayer()
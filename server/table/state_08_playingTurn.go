@@ -1,8 +1,6 @@
 package table
 
 import (
-	"time"
-
 	"github.com/DanTulovsky/deck"
 )
 
@@ -27,13 +25,14 @@ func (i *playingTurnState) Init() error {
 	}
 	i.table.board.AddCard(c)
 	i.l.Infof("Dealing the turn... [%v]", c)
+	i.table.recordStreet()
 
 	// next available player after the button goes first
 	i.table.currentTurn = i.table.playerAfter(i.table.buttonPosition)
 
 	pnt := i.table.positions[i.table.currentTurn]
 	i.l.Infof("Player %s (%d) goes first", pnt.Name, i.table.currentTurn)
-	pnt.WaitSince = time.Now()
+	pnt.WaitSince = i.table.clock.Now()
 
 	// records players that reached here
 	for _, p := range i.table.CurrentHandActivePlayers() {
@@ -64,7 +63,8 @@ func (i *playingTurnState) Tick() error {
 	if p == nil {
 		return nil
 	}
-	i.table.FoldIfTurnTimerEnd(p)
+	i.table.applyPreAction(p)
+	i.table.ActIfTurnTimerEnd(p)
 
 	if !p.ActionRequired() {
 		i.table.advancePlayer()
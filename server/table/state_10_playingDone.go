@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/DanTulovsky/deck"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
 	"github.com/DanTulovsky/pepper-poker-v2/poker"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 	"github.com/dustin/go-humanize"
@@ -21,6 +22,7 @@ func (i *playingDoneState) Init() error {
 
 	// Collect all the player hands.
 	var hands []*poker.PlayerHand
+	showdown := len(i.table.CurrentHandActivePlayers()) > 1
 	for _, p := range i.table.CurrentHandPlayers() {
 		i.l.Infof("[%v] => bet this hand: %v; => folded? %t", p.Name, humanize.Comma(i.table.pot.GetBet(p.ID)), p.Folded())
 
@@ -28,6 +30,9 @@ func (i *playingDoneState) Init() error {
 			cards := append(p.Hole(), i.table.board.Cards()...)
 			i.l.Infof("Adding [%v] to hands to check: %v", p.Name, cards)
 			ph := poker.NewPlayerHand(p.ID, cards)
+			if showdown {
+				ph.Hand = i.table.bestCombo(p)
+			}
 			hands = append(hands, ph)
 		}
 	}
@@ -46,6 +51,7 @@ func (i *playingDoneState) Init() error {
 		log.Fatal("Somehow all players managed to fold, how can that be?")
 	}
 	i.table.pot.Finalize(levels)
+	i.table.recordPot(i.table.pot.GetTotal())
 	// set winners on the table to return to clients
 	i.table.winners = levels
 
@@ -55,8 +61,7 @@ func (i *playingDoneState) Init() error {
 
 		if !p.Folded() && len(hands) > 1 {
 			// set the player's best hand
-			cards := append(p.Hole(), i.table.board.Cards()...)
-			hand := poker.BestCombo(cards...)
+			hand := i.table.bestCombo(p)
 
 			p.SetPlayerHand(&poker.PlayerHand{
 				Hand: hand,
@@ -78,14 +83,15 @@ func (i *playingDoneState) Init() error {
 
 			p.Stats.GamesWonInc()
 
+			// Return winnings to the stack, including the player's own bet
+			i.table.setStack(p, p.Money().Stack()+winnings, ledger.Pot(i.table.ID), "winnings")
+
 			// winner is someone who ends up with more money than they started with.
 			if i.table.pot.GetBet(p.ID) >= winnings {
 				winnings = 0 // did not actulaly win anything
 			}
 
 			p.SetWinnerAndWinnings(winnings)
-			// Return winnings to the stack
-			p.Money().SetStack(p.Money().Stack() + p.Money().Winnings())
 		}
 
 		// Set money sets
@@ -100,7 +106,14 @@ func (i *playingDoneState) Init() error {
 		}
 	}
 
+	i.table.finishHandHistory(showdown)
+
 	i.initrun = true
+
+	// a hand that creates or destroys chips stops the table
+	if err := i.table.auditHand(); err != nil {
+		return fmt.Errorf("ledger audit failed after the hand: %v", err)
+	}
 	return nil
 }
 
@@ -122,8 +135,7 @@ func (i *playingDoneState) Fold(p *player.Player) error {
 
 func (i *playingDoneState) Tick() error {
 	if !i.initrun {
-		i.Init()
-		return nil
+		return i.Init()
 	}
 
 	i.l.Debugf("Tick(%v)", i.Name())
@@ -26,11 +26,11 @@ func (i *finishedState) Init() error {
 	i.table.clearAckToken()
 
 	// Used to get an ack before game ends
-	i.token = acks.New(i.table.CurrentHandPlayers(), i.table.defaultAckTimeout)
+	i.token = acks.New(i.table.clock, i.table.CurrentHandPlayers(), i.table.defaultAckTimeout)
 	i.token.StartTimer()
 	i.table.setAckToken(i.token)
 
-	i.gameEndTime = time.Now()
+	i.gameEndTime = i.table.clock.Now()
 
 	i.initrun = true
 	return nil
@@ -62,7 +62,7 @@ func (i *finishedState) Tick() error {
 		return nil
 	}
 
-	now := time.Now()
+	now := i.table.clock.Now()
 
 	if i.token.NumStillToAck() > 0 && !i.token.Expired() {
 		status := fmt.Sprintf("Waiting (%v) for %d players to ack...", i.token.TimeRemaining().Truncate(time.Second), i.token.NumStillToAck())
@@ -85,8 +85,13 @@ func (i *finishedState) Tick() error {
 			i.table.clearAckToken()
 			i.token = nil
 
+			i.table.eliminateBustedPlayers()
+
 			i.l.Info("Removing players from current hand...")
+			i.table.refillTimeBanks()
+			i.table.applySeatChanges()
 			i.table.ClearCurrentHandPlayers()
+			i.table.applyBuyins()
 
 			i.table.resetStates()
 			// Stop sending old hand info to players... could be done better...
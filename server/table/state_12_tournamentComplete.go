@@ -0,0 +1,74 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+// tournamentCompleteState is the terminal state of a tournament table
+type tournamentCompleteState struct {
+	baseState
+}
+
+func (i *tournamentCompleteState) Init() error {
+	i.baseState.Init()
+
+	i.l.Info("Tournament complete!")
+	i.table.payTournament()
+
+	i.table.clearAckToken()
+	i.table.ClearCurrentHandPlayers()
+
+	i.initrun = true
+	return nil
+}
+
+func (i *tournamentCompleteState) Tick() error {
+	if !i.initrun {
+		i.Init()
+		return nil
+	}
+
+	i.l.Debugf("Tick(%v)", i.Name())
+
+	return nil
+}
+
+// AddPlayer rejects new players, the tournament is over
+func (i *tournamentCompleteState) AddPlayer(p *player.Player, opts AddPlayerOpts) (pos int, err error) {
+	return -1, fmt.Errorf("tournament is complete")
+}
+
+// AvailableToJoin returns false, the tournament is over
+func (i *tournamentCompleteState) AvailableToJoin() bool {
+	return false
+}
+
+func (i *tournamentCompleteState) AllIn(p *player.Player) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) Bet(p *player.Player, bet int64) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) BuyIn(p *player.Player, amount int64) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) Call(p *player.Player) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) Check(p *player.Player) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) Fold(p *player.Player) error {
+	return fmt.Errorf("tournament is complete")
+}
+
+func (i *tournamentCompleteState) WaitingTurnPlayer() *player.Player {
+	return nil
+}
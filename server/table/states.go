@@ -6,7 +6,6 @@ import (
 	"github.com/DanTulovsky/logger"
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
-	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -23,7 +22,7 @@ var (
 
 // state is the state machine for the table
 type state interface {
-	AddPlayer(player *player.Player) (pos int, err error)
+	AddPlayer(player *player.Player, opts AddPlayerOpts) (pos int, err error)
 	AvailableToJoin() bool
 
 	Bet(p *player.Player, bet int64) error
@@ -31,7 +30,7 @@ type state interface {
 	Call(p *player.Player) error
 	Fold(*player.Player) error
 	AllIn(*player.Player) error
-	BuyIn(*player.Player) error
+	BuyIn(p *player.Player, amount int64) error
 
 	Init() error
 	Name() ppb.GameState
@@ -91,7 +90,7 @@ func (i *baseState) WaitingTurnPlayer() *player.Player {
 
 // AvailableToJoin returns true if the table has empty positions
 func (i *baseState) AvailableToJoin() bool {
-	return i.table.numAvailablePlayers() < i.table.maxPlayers
+	return i.table.numPresentPlayers() < i.table.maxPlayers
 }
 
 // AddPlayer adds the player to the table
@@ -106,19 +105,21 @@ func (i *baseState) AvailableToJoin() bool {
 // }
 
 // AddPlayer adds the player to the table and returns the position at the table
-func (i *baseState) AddPlayer(p *player.Player) (pos int, err error) {
+func (i *baseState) AddPlayer(p *player.Player, opts AddPlayerOpts) (pos int, err error) {
 	if i.table.numPresentPlayers() == i.table.maxPlayers {
 		return -1, fmt.Errorf("no available positions at table")
 	}
 
 	if !i.table.playerAtTable(p) {
-		pos := i.table.randomAvailablePosition()
+		pos, err := i.table.openSeat(opts.Seat)
+		if err != nil {
+			return -1, err
+		}
 		i.table.positions[pos] = p
 		p.TablePosition, err = i.table.PlayerPosition(p)
 
 		// buy in
-		i.l.Infof("[%v] buying into the table ($%v)", p.Name, humanize.Comma(i.table.buyinAmount))
-		if err := i.BuyIn(p); err != nil {
+		if err := i.BuyIn(p, opts.Buyin); err != nil {
 			i.table.removePlayer(p)
 			return -1, err
 		}
@@ -142,13 +143,13 @@ func (i *baseState) AllIn(p *player.Player) error {
 }
 
 // BuyIn process the buyin request. The player must already be sitting (have position) at the table
-func (i *baseState) BuyIn(p *player.Player) error {
+func (i *baseState) BuyIn(p *player.Player, amount int64) error {
 
 	if p.TablePosition < 0 {
 		return fmt.Errorf("must JoinTable before tyring to buyin")
 	}
 
-	return i.table.buyin(p)
+	return i.table.buyin(p, amount)
 }
 
 // Bet processes the bet request
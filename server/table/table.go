@@ -4,13 +4,18 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/DanTulovsky/deck"
 	"github.com/DanTulovsky/logger"
 	"github.com/DanTulovsky/pepper-poker-v2/acks"
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
 	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
 	"github.com/DanTulovsky/pepper-poker-v2/poker"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 	"github.com/Pallinder/go-randomdata"
@@ -37,9 +42,40 @@ type Table struct {
 	// players playing the current hand
 	currentHandPlayers []*player.Player
 
+	// the config the table was created with
+	config TableConfig
+	// tells the time to the table timers
+	clock clock.Clock
+	// records the movements of the table's money and chips
+	ledger *ledger.Ledger
+	// draws the deck seed of every hand and picks seats
+	rng *rand.Rand
+	// shuffled the deck of the current hand
+	deckSeed int64
+	// the recorded hand dealt again, see Replay
+	replay *replayHand
+	// who can join a private table
+	access *access
+	// pending top-ups and auto-rebuy settings
+	buyins map[id.PlayerID]*playerBuyin
+	// seats players move to once the current hand is done
+	seatChanges map[id.PlayerID]int
+	// players that straddle whenever they are under the gun
+	straddlers map[id.PlayerID]bool
+	// extra time to act of each seated player
+	timeBanks map[id.PlayerID]*timeBank
+
 	maxPlayers int
 	minPlayers int
 
+	// the poker variant played at this table
+	variant ppb.GameVariant
+	// computes the legal bet sizes at this table
+	bettingStructure BettingStructure
+
+	// set for sit-and-go tournament tables, nil at cash tables
+	tournament *Tournament
+
 	waitingPlayersState state
 	initializingState   state
 	readyToStartState   state
@@ -53,6 +89,8 @@ type Table struct {
 	playingDoneState       state
 	finishedState          state
 
+	tournamentCompleteState state
+
 	State state
 
 	// acks are used to get clients to ack at specific points in time (e.g. game start)
@@ -64,10 +102,16 @@ type Table struct {
 	// the current buttonPosition, index into positions array
 	buttonPosition                       int
 	bigBlindPosition, smallBlindPosition int
+	// blind positions of the previous hand, to charge the blinds missed by players sitting out
+	lastBigBlindPosition, lastSmallBlindPosition int
 
 	bigBlindPlayer, smallBlindPlayer *player.Player
 	bigBlind, smallBlind             int64
+	ante                             int64
+	straddled                        bool // the player under the gun straddled this hand
 	minBetThisRound                  int64
+	lastRaiseThisRound               int64 // size of the last full bet or raise this round
+	raisesThisRound                  int   // number of bets and raises this round
 	pot                              *poker.Pot
 	board                            *poker.Board
 	deck                             *deck.Deck
@@ -75,45 +119,105 @@ type Table struct {
 	currentHand                      int64 // allows tracking metrics by hand
 	winners                          []poker.Winners
 
+	// lobby view of the table, read by the manager without waiting on the table
+	summary atomic.Value
+	// pots of the recent hands
+	recentPots []int64
+
+	// events published to the players, the seq of the last event sent to each player, and the last turn published
+	// changed is set when the table changed without an event, to send everyone the new state
+	events       []*ppb.TableEvent
+	eventSeq     int64
+	delivered    map[id.PlayerID]int64
+	lastTurn     turnKey
+	changed      bool
+	lastSnapshot time.Time
+
+	// spectators, and the last table info sent to them
+	watchers      []*Watcher
+	lastWatchInfo *ppb.GameInfo
+
+	// the hand being recorded, and the most recent recorded hands
+	hand        *handhistory.Hand
+	handHistory *handhistory.Store
+
 	// how long to wait for player to make a move
 	playerTimeout time.Duration
 	// how long to wait after game ends before starting a new one
 	gameEndDelay time.Duration
 	// how long to wait between state transitions
 	stateAdvanceDelay time.Duration
+	// when the table changed to the current state, on the table clock
+	stateSince time.Time
 	// how long to wait after the last payer is added before starting the game
 	gameWaitTimeout time.Duration
 
 	gameStartsInTime time.Duration
 
+	// closed to stop the table
+	stop     chan struct{}
+	stopOnce sync.Once
+
 	l *logger.Logger
 }
 
-// New creates a new table
-func New(tableAction chan ActionRequest) *Table {
+// New creates a new table with the given config, the config must be valid
+func New(tableAction chan ActionRequest, c TableConfig) *Table {
+	name := c.Name
+	if name == "" {
+		name = randomdata.SillyName()
+	}
+
+	clk := clock.OrReal(c.Clock)
+	if c.Ledger == nil {
+		c.Ledger = chipLedger
+	}
+	seed := c.Seed
+	if seed == 0 {
+		seed = clk.Now().UnixNano()
+	}
+
 	t := &Table{
 		ID:                 id.NewTableID(),
-		Name:               randomdata.SillyName(),
+		Name:               name,
 		TableAction:        tableAction,
+		config:             c,
+		clock:              clk,
+		ledger:             c.Ledger,
+		rng:                rand.New(rand.NewSource(seed)),
+		access:             newAccess(c.Password, c.Invited),
+		buyins:             make(map[id.PlayerID]*playerBuyin),
+		seatChanges:        make(map[id.PlayerID]int),
+		straddlers:         make(map[id.PlayerID]bool),
+		timeBanks:          make(map[id.PlayerID]*timeBank),
+		delivered:          make(map[id.PlayerID]int64),
+		variant:            c.Variant,
+		bettingStructure:   NewBettingStructure(c.BettingStructure),
 		l:                  logger.New("table", color.New(color.FgYellow)),
 		board:              poker.NewBoard(),
 		pot:                poker.NewPot(),
 		currentHandPlayers: []*player.Player{},
+		stop:               make(chan struct{}),
+		handHistory:        handhistory.NewStore(handHistorySize),
 
-		maxPlayers: 7,
-		minPlayers: 2,
+		maxPlayers: c.MaxPlayers,
+		minPlayers: c.MinPlayers,
 
 		buttonPosition:     -1,
 		smallBlindPosition: -1,
 		bigBlindPosition:   -1,
-		smallBlind:         5,
-		bigBlind:           10,
-		buyinAmount:        1000,
+		smallBlind:         c.SmallBlind,
+		bigBlind:           c.BigBlind,
+		ante:               c.Ante,
+		buyinAmount:        c.MaxBuyin * c.BigBlind,
+
+		lastBigBlindPosition:   -1,
+		lastSmallBlindPosition: -1,
 
 		defaultAckTimeout: time.Second * 10,
-		playerTimeout:     time.Second * 30,
+		playerTimeout:     c.PlayerTimeout,
 		gameEndDelay:      time.Second * 10,
-		gameWaitTimeout:   time.Second * 10,
+		gameWaitTimeout:   c.GameWaitTimeout,
 		stateAdvanceDelay: time.Second * 0,
 	}
 
@@ -154,6 +258,9 @@ func New(tableAction chan ActionRequest) *Table {
 		baseState:    newBaseState(ppb.GameState_GameStateFinished, t),
 		gameEndDelay: t.gameEndDelay,
 	}
+	t.tournamentCompleteState = &tournamentCompleteState{
+		baseState: newBaseState(ppb.GameState_GameStateTournamentComplete, t),
+	}
 
 	t.State = t.waitingPlayersState
 	t.State.Init()
@@ -165,24 +272,57 @@ func New(tableAction chan ActionRequest) *Table {
 func (t *Table) Run() error {
 	t.l.Infof("Table [%v] starting run loop...", t.Name)
 
-	ticker := time.NewTicker(*tickDelay)
+	ticker := t.clock.NewTicker(*tickDelay)
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			if err := t.Tick(); err != nil {
+				ticker.Stop()
+				t.l.Errorf("Table [%v] halted: %v", t.Name, err)
+
+				// the players get their chips back, and the manager sees the table close
+				for _, p := range t.PresentPlayers() {
+					t.cashOut(p)
+				}
+				t.Stop()
+				t.shutdown()
 				return err
 			}
+		case <-t.stop:
+			ticker.Stop()
+			t.l.Infof("Table [%v] stopping...", t.Name)
+			t.shutdown()
+			return nil
 		}
 	}
+}
+
+// shutdown closes the comm channels of anyone still at or watching the table
+func (t *Table) shutdown() {
+	for _, p := range t.PresentPlayers() {
+		t.removePlayer(p)
+	}
+	t.stopWatchers()
+}
+
+// Stop stops the table run loop, the table must no longer be sent any actions
+// It is safe to call more than once, and after the table halted on its own.
+func (t *Table) Stop() {
+	t.stopOnce.Do(func() { close(t.stop) })
+}
 
-	// return nil
+// Done returns a channel that is closed once the table is stopped
+func (t *Table) Done() <-chan struct{} {
+	return t.stop
 }
 
 // ResetPlayersBets resets player bet this round
 func (t *Table) ResetPlayersBets() {
 
 	t.minBetThisRound = 0
+	t.lastRaiseThisRound = 0
+	t.raisesThisRound = 0
 	for _, p := range t.CurrentHandPlayers() {
 		p.ResetForBettingRound()
 	}
@@ -192,10 +332,16 @@ func (t *Table) ResetPlayersBets() {
 func (t *Table) Tick() error {
 	t.l.Debug("Tick()")
 
+	t.expireHeldSeats()
 	t.sendUpdateToPlayers()
+	t.sendUpdateToWatchers()
+	t.updateSummary()
 
-	if err := t.State.Tick(); err != nil {
-		return err
+	// a new state runs once the state advance delay passed, without blocking the table
+	if t.clock.Now().Sub(t.stateSince) >= t.stateAdvanceDelay {
+		if err := t.State.Tick(); err != nil {
+			return err
+		}
 	}
 
 	if err := t.processManagerActions(); err != nil {
@@ -208,22 +354,34 @@ func (t *Table) Tick() error {
 // setAckToken sets an ack for the table
 func (t *Table) setAckToken(tok *acks.Token) {
 	t.currentAckToken = tok
+	t.changed = true
 
 }
 
 // clearAckToken clears the ack
 func (t *Table) clearAckToken() {
 	t.currentAckToken = nil
+	t.changed = true
 
 }
 
-// FoldIfTurnTimerEnd will fold the player if their turn timer runs out
-func (t *Table) FoldIfTurnTimerEnd(p *player.Player) {
+// ActIfTurnTimerEnd acts for the player once their turn timer and time bank run out
+// The player checks if checking is free, and folds otherwise.
+func (t *Table) ActIfTurnTimerEnd(p *player.Player) {
+	if !p.ActionRequired() || t.TimeBankLeft(p) > 0 || t.TurnTimeLeft(p) > 0 {
+		return
+	}
 
-	if t.TurnTimeLeft(p) < 0 {
-		t.l.Infof("[%v] turn timed out (%v), folding...", t.playerTimeout, p.Username)
-		p.Fold()
+	if t.betLimits(p).Call == 0 {
+		t.l.Infof("[%v] turn timed out (%v), checking...", p.Username, t.playerTimeout)
+		if err := t.check(p); err != nil {
+			t.l.Error(err)
+		}
+		return
 	}
+
+	t.l.Infof("[%v] turn timed out (%v), folding...", p.Username, t.playerTimeout)
+	t.fold(p)
 }
 
 // TurnTimeLeft returns the turn time left
@@ -233,7 +391,7 @@ func (t *Table) TurnTimeLeft(p *player.Player) time.Duration {
 		return 0
 	}
 
-	return t.playerTimeout - time.Now().Sub(p.WaitSince)
+	return t.playerTimeout - t.clock.Since(p.WaitSince)
 }
 
 // processManagerActions checks the channel from the manager for any player actions
@@ -244,23 +402,44 @@ func (t *Table) processManagerActions() error {
 		if err := t.processManagerAction(in); err != nil {
 			t.l.Errorf("received nil player for %v", in.Action)
 		}
+		// players joining, buying in, sitting out... change the table without publishing an event
+		if in.Action != actions.ActionInfo {
+			t.changed = true
+		}
 	default:
 	}
 
 	return nil
 }
 
+// Do runs the action on the calling goroutine and returns the result
+// It is for tables that are ticked by hand, such as in a simulation, instead of started with Run.
+func (t *Table) Do(a actions.TableAction, p *player.Player, opts interface{}) ActionResult {
+	result := make(chan ActionResult, 1)
+	if err := t.processManagerAction(NewTableAction(a, result, p, opts)); err != nil {
+		return NewTableActionResult(err, nil)
+	}
+	if a != actions.ActionInfo {
+		t.changed = true
+	}
+	return <-result
+}
+
 func (t *Table) processManagerAction(in ActionRequest) error {
 	var res ActionResult
 
 	// Awkward...
-	if in.Player == nil && in.Action != actions.ActionInfo && in.Action != actions.ActionAddPlayer {
+	if in.Player == nil && in.Action != actions.ActionInfo && in.Action != actions.ActionAddPlayer && in.Action != actions.ActionReleasePlayer && in.Action != actions.ActionWatch {
 		return fmt.Errorf("received nil player for %v", in.Action)
 	}
 
 	switch in.Action {
 	case actions.ActionAddPlayer:
-		pos, err := t.addPlayer(in.Player)
+		opts, ok := in.Opts.(AddPlayerOpts)
+		if !ok {
+			opts.Seat = -1
+		}
+		pos, err := t.addPlayer(in.Player, opts)
 		switch err {
 		case nil:
 			res = NewTableActionResult(nil, ActionAddPlayerResult{
@@ -279,17 +458,71 @@ func (t *Table) processManagerAction(in ActionRequest) error {
 		i := t.info()
 		res = NewTableActionResult(nil, i)
 
+	case actions.ActionWatch:
+		t.addWatcher(in.Opts.(*Watcher))
+		res = NewTableActionResult(nil, nil)
+
+	case actions.ActionReleasePlayer:
+		p, err := t.releasePlayer(in.Player)
+		res = NewTableActionResult(err, p)
+
+	case actions.ActionSeatMovedPlayer:
+		from := in.Opts.(id.TableID)
+		pos, err := t.seatMovedPlayer(in.Player, from)
+		switch err {
+		case nil:
+			res = NewTableActionResult(nil, ActionAddPlayerResult{
+				Position: pos,
+			})
+		default:
+			res = NewTableActionResult(err, nil)
+		}
+
 	case actions.ActionAckToken:
 		token := in.Opts.(string)
 		err := t.ackToken(in.Player, token)
 		res = NewTableActionResult(err, nil)
 
 	case actions.ActionDisconnect:
-		err := t.PlayerDisconnected(in.Player)
-		res = NewTableActionResult(err, nil)
+		cc, _ := in.Opts.(chan actions.GameData)
+		held, err := t.playerStreamClosed(in.Player, cc)
+		res = NewTableActionResult(err, held)
 
 	case actions.ActionBuyIn:
-		err := t.State.BuyIn(in.Player)
+		amount := in.Opts.(int64)
+		err := t.State.BuyIn(in.Player, amount)
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionLeaveTable:
+		err := t.leaveTable(in.Player)
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionSitOut:
+		err := t.sitOut(in.Player, in.Opts.(int))
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionChangeSeat:
+		err := t.changeSeat(in.Player, in.Opts.(int))
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionSitIn:
+		err := t.sitIn(in.Player)
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionSnapshot:
+		t.requestSnapshot(in.Player)
+		res = NewTableActionResult(nil, nil)
+
+	case actions.ActionPreAction:
+		err := t.setPreAction(in.Player, in.Opts.(ppb.PreAction))
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionStraddle:
+		err := t.setStraddle(in.Player, in.Opts.(bool))
+		res = NewTableActionResult(err, nil)
+
+	case actions.ActionAutoRebuy:
+		err := t.setAutoRebuy(in.Player, in.Opts.(*ppb.AutoRebuy))
 		res = NewTableActionResult(err, nil)
 
 	case actions.ActionCheck:
@@ -330,7 +563,13 @@ func (t *Table) ackToken(p *player.Player, token string) error {
 		return fmt.Errorf("current token is [%v], sent token is [%v]", t.currentAckToken, token)
 	}
 
-	return t.currentAckToken.Ack(p)
+	if err := t.currentAckToken.Ack(p); err != nil {
+		return err
+	}
+
+	// the client is now using this table
+	p.MovedFrom = ""
+	return nil
 }
 
 func (t *Table) registerPlayerCC(p *player.Player, cc chan actions.GameData) error {
@@ -338,28 +577,65 @@ func (t *Table) registerPlayerCC(p *player.Player, cc chan actions.GameData) err
 	if cc == nil {
 		return fmt.Errorf("nil comm channel for player")
 	}
+
+	if p.Disconnected() {
+		t.l.Infof("[%v] reconnected", p.Name)
+		p.Reconnect()
+	}
+	// ends the stream the player is reconnecting from, if it is still open
+	if p.CommChannel != nil && p.CommChannel != cc {
+		close(p.CommChannel)
+	}
 	p.CommChannel = cc
+	t.requestSnapshot(p)
 
 	return nil
 }
 
 // info returns table info
 func (t *Table) info() ActionInfoResult {
-	return ActionInfoResult{
+	i := ActionInfoResult{
 		AvailableToJoin: t.AvailableToJoin(),
 		Name:            t.Name,
 		MaxPlayers:      t.maxPlayers,
 		MinPlayers:      t.minPlayers,
+		Players:         t.numPresentPlayers(),
+		Private:         t.config.Private,
 	}
 
+	if t.tournament != nil {
+		i.TournamentPlayers = t.tournamentPlayers()
+	}
+	return i
+
 }
 
 // infoproto returns t.info() in a proto to send to the client
 func (t *Table) infoproto() *ppb.GameInfo {
+	gi := t.publicInfoproto()
+
+	if t.currentAckToken != nil {
+		gi.AckToken = t.currentAckToken.String()
+	}
+
+	if t.State == t.finishedState || t.State == t.playingDoneState || t.State == t.tournamentCompleteState {
+		gi.Players = t.confPlayersProto()
+	}
+
+	return gi
+}
+
+// publicInfoproto returns the table info anyone can see, including spectators
+// Hole cards are not included, hands are only shown at showdown
+func (t *Table) publicInfoproto() *ppb.GameInfo {
 	i := t.info()
+	minBuyin, maxBuyin := t.buyinRange()
 	gi := &ppb.GameInfo{
 		TableName: i.Name,
 		TableID:   t.ID.String(),
+		Variant:   t.variant,
+
+		BettingStructure: t.bettingStructure.Type(),
 
 		GameState:          t.State.Name(),
 		GameStartsInSec:    int64(t.gameStartsInTime.Seconds()),
@@ -369,23 +645,19 @@ func (t *Table) infoproto() *ppb.GameInfo {
 		MinPlayers: int64(i.MinPlayers),
 		BigBlind:   t.bigBlind,
 		SmallBlind: t.smallBlind,
-		Buyin:      t.buyinAmount,
+		Ante:       t.ante,
+		Buyin:      maxBuyin,
+		MinBuyin:   minBuyin,
 
 		ButtonPosition:     int64(t.buttonPosition),
 		SmallBlindPosition: int64(t.smallBlindPosition),
 		BigBlindPosition:   int64(t.bigBlindPosition),
 
 		CommunityCards: t.board.AsProto(),
-	}
 
-	if t.currentAckToken != nil {
-		gi.AckToken = t.currentAckToken.String()
-	}
+		Tournament: t.tournamentProto(),
 
-	if t.State == t.finishedState || t.State == t.playingDoneState {
-		gi.Players = t.confPlayersProto()
-	} else {
-		gi.Players = t.playersProto()
+		Players: t.playersProto(),
 	}
 
 	gi.WinningIds = t.winningPlayersProto()
@@ -412,7 +684,7 @@ func (t *Table) winningPlayersProto() []*ppb.Winners {
 func (t *Table) playersProto() []*ppb.Player {
 	players := []*ppb.Player{}
 
-	for _, p := range t.ActivePlayers() {
+	for _, p := range t.PresentPlayers() {
 		players = append(players, t.playerProto(p))
 	}
 
@@ -423,7 +695,7 @@ func (t *Table) playersProto() []*ppb.Player {
 // confidential information is included
 func (t *Table) confPlayersProto() []*ppb.Player {
 	players := []*ppb.Player{}
-	for _, p := range t.ActivePlayers() {
+	for _, p := range t.PresentPlayers() {
 		players = append(players, t.confPlayerProto(p))
 	}
 
@@ -433,7 +705,8 @@ func (t *Table) confPlayersProto() []*ppb.Player {
 // playerProto returns the player as a proto
 // no confidential information is included
 func (t *Table) playerProto(p *player.Player) *ppb.Player {
-	pl := p.AsProto(t.bigBlind, t.buyinAmount)
+	minBuyin, _ := t.buyinRange()
+	pl := p.AsProto(t.bigBlind, minBuyin)
 
 	pl.GetMoney().MinBetThisRound = t.minBetThisRound
 	pl.GetMoney().Pot = t.pot.GetTotal()
@@ -452,7 +725,8 @@ func (t *Table) playerProto(p *player.Player) *ppb.Player {
 
 // confPlayerProto returns the player as a proto, including confidential info
 func (t *Table) confPlayerProto(p *player.Player) *ppb.Player {
-	pl := p.AsProto(t.bigBlind, t.buyinAmount)
+	minBuyin, _ := t.buyinRange()
+	pl := p.AsProto(t.bigBlind, minBuyin)
 
 	pl.Money.MinBetThisRound = t.minBetThisRound
 	pl.Money.Pot = t.pot.GetTotal()
@@ -476,6 +750,8 @@ func (t *Table) gameDataProto(p *player.Player) *ppb.GameData {
 	d := &ppb.GameData{
 		Info:     t.infoproto(),
 		PlayerID: p.ID.String(),
+
+		MovedFromTableID: p.MovedFrom.String(),
 	}
 
 	pl := t.State.WaitingTurnPlayer()
@@ -484,18 +760,52 @@ func (t *Table) gameDataProto(p *player.Player) *ppb.GameData {
 		d.WaitTurnName = pl.Name
 		d.WaitTurnNum = pl.CurrentTurn
 		d.WaitTurnTimeLeftSec = int64(t.TurnTimeLeft(pl).Seconds())
+		if d.WaitTurnTimeLeftSec < 0 {
+			d.WaitTurnTimeLeftSec = 0
+		}
 		d.WaitTurnTimeMaxSec = int64(t.playerTimeout.Seconds())
+		d.WaitTurnTimeBankSec = int64(t.TimeBankLeft(pl).Seconds())
 	}
 
 	// p is the player the info is being sent to, add confidential info
 	d.Player = t.confPlayerProto(p)
+	d.PreAction, d.PreActionCallAmount = p.PreAction()
 	if pl == p {
 		d.Player.State = append(d.Player.State, ppb.PlayerState_PlayerStateCurrentTurn)
+
+		limits := t.betLimits(p)
+		d.AllowedActions = t.allowedActions(p)
+		d.CallAmount = limits.Call
+		if limits.CanRaise {
+			d.MinBet = limits.MinRaise
+			d.MaxBet = limits.MaxRaise
+		}
 	}
 
 	return d
 }
 
+// numHoleCards returns the number of hole cards dealt to each player
+func (t *Table) numHoleCards() int {
+	switch t.variant {
+	case ppb.GameVariant_GameVariantPotLimitOmaha:
+		return poker.OmahaHoleCards
+	default:
+		return 2
+	}
+}
+
+// bestCombo returns the best hand the player can make with the board according to the table variant
+func (t *Table) bestCombo(p *player.Player) *poker.Hand {
+	switch t.variant {
+	case ppb.GameVariant_GameVariantPotLimitOmaha:
+		return poker.BestOmahaCombo(p.Hole(), t.board.Cards())
+	default:
+		cards := append(p.Hole(), t.board.Cards()...)
+		return poker.BestCombo(cards...)
+	}
+}
+
 // advancePlayer advances t.currentPlayer to the next player
 func (t *Table) advancePlayer() {
 
@@ -506,7 +816,7 @@ func (t *Table) advancePlayer() {
 		for _, p := range t.CurrentHandActivePlayers() {
 			// there is only one current
 			t.currentTurn = p.TablePosition
-			p.WaitSince = time.Now()
+			p.WaitSince = t.clock.Now()
 		}
 
 		return
@@ -522,12 +832,16 @@ func (t *Table) advancePlayer() {
 
 	nextPlayer := t.positions[t.currentTurn]
 	if nextPlayer != nil {
-		nextPlayer.WaitSince = time.Now()
+		nextPlayer.WaitSince = t.clock.Now()
 	}
 }
 
 // AvailableToJoin returns true if the table has empty positions
+// Tournament tables are closed once the tournament starts
 func (t *Table) AvailableToJoin() bool {
+	if t.tournament != nil && t.tournament.Started() {
+		return false
+	}
 	return t.State.AvailableToJoin()
 }
 
@@ -542,6 +856,17 @@ func (t *Table) playerAfter(index int) int {
 	return index
 }
 
+// playerBefore returns the index of the first non-empty chair before index, includes only players in the current hand
+func (t *Table) playerBefore(index int) int {
+	for i := 0; i < t.maxPlayers; i++ {
+		index = (index - 1 + t.maxPlayers) % t.maxPlayers
+		if t.positions[index] != nil && t.positions[index].InList(t.currentHandPlayers) {
+			break
+		}
+	}
+	return index
+}
+
 // canAdvanceState returns true if the state can advance
 func (t *Table) canAdvanceState() bool {
 	for _, p := range t.CurrentHandPlayers() {
@@ -559,8 +884,9 @@ func (t *Table) setState(s state) error {
 	to = s.Name().String()
 
 	t.l.Infof(color.GreenString("Changing State (%v): %v -> %v"), t.stateAdvanceDelay, from, to)
-	time.Sleep(t.stateAdvanceDelay)
 	t.State = s
+	t.stateSince = t.clock.Now()
+	t.publish(&ppb.TableEvent{Type: ppb.TableEventType_TableEventStateChanged, GameState: s.Name()})
 
 	t.resetPlayerActions()
 	return nil
@@ -606,12 +932,12 @@ func (t *Table) ClearCurrentHandPlayers() {
 }
 
 // ActivePlayers returns the players at the table
-// Active players are present at the table
+// Active players are present at the table and not sitting out
 func (t *Table) ActivePlayers() []*player.Player {
 	players := []*player.Player{}
 
 	for _, p := range t.positions {
-		if p != nil {
+		if p != nil && !p.SittingOut() {
 			players = append(players, p)
 		}
 	}
@@ -620,12 +946,13 @@ func (t *Table) ActivePlayers() []*player.Player {
 }
 
 // AvailablePlayers returns the available players at the table
-// Available players are present at the table and have a non-zero stack
+// Available players are present at the table, have a non-zero stack and are dealt into the next hand
+// Disconnected players are dealt in at tournaments only, where they are blinded away until they reconnect.
 func (t *Table) AvailablePlayers() []*player.Player {
 	players := []*player.Player{}
 
 	for _, p := range t.positions {
-		if p != nil && p.Money().Stack() > 0 {
+		if p != nil && p.Money().Stack() > 0 && p.SeatState() == player.SeatPlaying && (!p.Disconnected() || t.tournament != nil) {
 			players = append(players, p)
 		}
 	}
@@ -676,18 +1003,19 @@ func (t *Table) PlayerPosition(p *player.Player) (int, error) {
 
 // PlayerDisconnected handles a player disconnecting
 func (t *Table) PlayerDisconnected(p *player.Player) error {
+	if !t.playerAtTable(p) {
+		return fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+
+	if p.InList(t.currentHandPlayers) && !p.Folded() {
+		t.recordAction(p, handhistory.EventFold, 0)
+	}
 	p.Fold()
 	p.Stats.ActionInc(actions.ActionDisconnect)
 
 	p.SetActionRequired(false)
 
-	// return Stack() to Bank()
-	stack := p.Money().Stack()
-	bank := p.Money().Bank()
-	p.Money().SetStack(0)
-	p.Money().SetBank(bank + stack)
-
-	t.l.Infof("[%v] disconnected, returning [%v] stack to bank (now = %v)", p.Name, stack, p.Money().Bank())
+	t.cashOut(p)
 
 	t.l.Infof("[%v] disconnected, removing from table [%v]...", p.Name, t.Name)
 	pos := p.TablePosition
@@ -706,12 +1034,24 @@ func (t *Table) removePlayer(p *player.Player) {
 		return
 	}
 
-	// ack any outstanding acks for the playr
+	p.DisconnectReset()
+	t.unseat(p)
+}
+
+// unseat frees the player's seat, removes the player from the current hand and forgets the player's state at the table
+// The player's comm channel is left alone.
+func (t *Table) unseat(p *player.Player) {
+	// ack any outstanding acks for the player
 	if t.currentAckToken != nil {
 		t.currentAckToken.Ack(p)
 	}
 
-	p.DisconnectReset()
+	delete(t.buyins, p.ID)
+	delete(t.seatChanges, p.ID)
+	delete(t.straddlers, p.ID)
+	delete(t.timeBanks, p.ID)
+	delete(t.delivered, p.ID)
+	p.ResetSeat()
 
 	var i int
 	for _, pl := range t.currentHandPlayers {
@@ -735,6 +1075,7 @@ func (t *Table) removePlayer(p *player.Player) {
 	t.l.Debugf("positions before: %v", t.positions)
 	t.positions[p.TablePosition] = nil
 	t.l.Debugf("positions after: %v", t.positions)
+	p.TablePosition = -1
 }
 
 // playerAtTable returns true if the player is at this table
@@ -748,8 +1089,8 @@ func (t *Table) playerAtTable(p *player.Player) bool {
 }
 
 // addPlayer adds a player to the table
-func (t *Table) addPlayer(p *player.Player) (int, error) {
-	return t.State.AddPlayer(p)
+func (t *Table) addPlayer(p *player.Player, opts AddPlayerOpts) (int, error) {
+	return t.State.AddPlayer(p, opts)
 }
 
 func (t *Table) nextAvailablePosition() int {
@@ -774,7 +1115,7 @@ func (t *Table) randomAvailablePosition() int {
 		return -1
 	}
 
-	return positions[rand.Intn(len(positions))]
+	return positions[t.rng.Intn(len(positions))]
 }
 
 // SetPlayersActionRequired resets the actionRequired attribute on players before each state
@@ -797,38 +1138,6 @@ func (t *Table) haveWinner() bool {
 	return active < 2
 }
 
-// sendUpdateToPlayers sends updates to players as needed
-func (t *Table) sendUpdateToPlayers() {
-
-	// pl := t.State.WaitingTurnPlayer()
-
-	// TODO: This breaks the flow of updates when, e.g., players go all-in
-	// // don't send player updates if not waiting for a player's turn
-	// if pl == nil {
-	// 	// unless there is an ack token
-	// 	if t.currentAckToken != nil && t.currentAckToken.AllAcked() {
-	// 		return
-	// 	}
-	// }
-
-	// note that this sends updates to all ActivePlayers, not just the ones playing a hand
-	for _, p := range t.ActivePlayers() {
-		in := t.gameDataProto(p)
-		action := actions.NewGameData(in)
-
-		if p.CommChannel == nil {
-			t.l.Debugf("player [%v] has nil comm channel", p.Name)
-			continue
-		}
-
-		t.l.Debugf("Sending update to %v", p.Name)
-		select {
-		case p.CommChannel <- action:
-		default:
-		}
-	}
-}
-
 // playersReady returns true if all players are ready
 func (t *Table) playersReady() bool {
 	for _, p := range t.ActivePlayers() {
@@ -876,5 +1185,6 @@ func (t *Table) resetStates() {
 	t.playingRiverState.Reset()
 	t.playingDoneState.Reset()
 	t.finishedState.Reset()
+	t.tournamentCompleteState.Reset()
 
 }
@@ -0,0 +1,86 @@
+package table
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// access controls who can join a private table
+// The invites change while the table runs and are checked by the manager, so they have their own lock.
+type access struct {
+	mu       sync.Mutex
+	password string
+	invited  map[string]bool
+}
+
+func newAccess(password string, invited []string) *access {
+	a := &access{
+		password: password,
+		invited:  make(map[string]bool),
+	}
+	for _, u := range invited {
+		a.invited[u] = true
+	}
+	return a
+}
+
+// CanJoin returns an error if the user may not join or watch the table
+// Anyone can join a public table, a private table needs an invite or the password.
+func (t *Table) CanJoin(username, password string) error {
+	if !t.config.Private || username == t.config.Owner {
+		return nil
+	}
+
+	t.access.mu.Lock()
+	defer t.access.mu.Unlock()
+
+	if t.access.invited[username] {
+		return nil
+	}
+	if t.access.password != "" && subtle.ConstantTimeCompare([]byte(password), []byte(t.access.password)) == 1 {
+		return nil
+	}
+	return fmt.Errorf("table [%v] is private, an invite or the password is required", t.Name)
+}
+
+// UpdateInvites invites and revokes users, only the owner of a private table can do this
+// Revoking an invite does not remove a player already seated.
+func (t *Table) UpdateInvites(username string, invite, revoke []string) ([]string, error) {
+	if !t.config.Private {
+		return nil, fmt.Errorf("table [%v] is not private", t.Name)
+	}
+	if username != t.config.Owner {
+		return nil, fmt.Errorf("only the owner of table [%v] can change the invites", t.Name)
+	}
+
+	t.access.mu.Lock()
+	defer t.access.mu.Unlock()
+
+	for _, u := range invite {
+		t.access.invited[u] = true
+	}
+	for _, u := range revoke {
+		delete(t.access.invited, u)
+	}
+
+	return t.invitedLocked(), nil
+}
+
+// Invited returns the users invited to the table
+func (t *Table) Invited() []string {
+	t.access.mu.Lock()
+	defer t.access.mu.Unlock()
+
+	return t.invitedLocked()
+}
+
+func (t *Table) invitedLocked() []string {
+	invited := []string{}
+	for u := range t.access.invited {
+		invited = append(invited, u)
+	}
+	sort.Strings(invited)
+	return invited
+}
@@ -0,0 +1,52 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanJoin(t *testing.T) {
+	c := DefaultTableConfig()
+	c.Private = true
+	c.Owner = "owner"
+	c.Password = "secret"
+	c.Invited = []string{"friend"}
+	tbl := New(make(chan ActionRequest), c)
+
+	tests := []struct {
+		name               string
+		username, password string
+		wantErr            bool
+	}{
+		{name: "owner", username: "owner"},
+		{name: "invited", username: "friend"},
+		{name: "password", username: "stranger", password: "secret"},
+		{name: "wrong password", username: "stranger", password: "guess", wantErr: true},
+		{name: "no password", username: "stranger", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tbl.CanJoin(tt.username, tt.password); (err != nil) != tt.wantErr {
+				t.Errorf("CanJoin(%q, %q) = %v, wantErr %v", tt.username, tt.password, err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := tbl.UpdateInvites("friend", []string{"stranger"}, nil); err == nil {
+		t.Errorf("UpdateInvites() by a player other than the owner = nil, want error")
+	}
+
+	invited, err := tbl.UpdateInvites("owner", []string{"stranger"}, []string{"friend"})
+	if err != nil {
+		t.Fatalf("UpdateInvites() = %v", err)
+	}
+	if want := []string{"stranger"}; !reflect.DeepEqual(invited, want) {
+		t.Errorf("UpdateInvites() = %v, want %v", invited, want)
+	}
+	if err := tbl.CanJoin("friend", ""); err == nil {
+		t.Errorf("CanJoin() after the invite was revoked = nil, want error")
+	}
+	if err := tbl.CanJoin("stranger", ""); err != nil {
+		t.Errorf("CanJoin() after the invite = %v, want nil", err)
+	}
+}
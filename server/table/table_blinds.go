@@ -0,0 +1,142 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+// setBlindPositions moves the button and the blinds for a new hand, following the dead button rule
+// The big blind moves to the first player after the last big blind, the small blind to the seat of the last big blind
+// and the button to the seat of the last small blind. When those players are gone the small blind is not posted and
+// the button stays on an empty seat, so no one ever skips or pays a blind twice.
+// Heads up, the button posts the small blind.
+func (t *Table) setBlindPositions() {
+	if t.replay != nil {
+		t.buttonPosition, t.smallBlindPosition, t.bigBlindPosition = t.replay.button, t.replay.smallBlind, t.replay.bigBlind
+		return
+	}
+
+	headsUp := t.NumCurrentHandPlayers() == 2
+
+	if t.lastBigBlindPosition < 0 {
+		t.buttonPosition = t.playerAfter(t.buttonPosition)
+		t.smallBlindPosition = t.playerAfter(t.buttonPosition)
+		if headsUp {
+			t.smallBlindPosition = t.buttonPosition
+		}
+		t.bigBlindPosition = t.playerAfter(t.smallBlindPosition)
+		return
+	}
+
+	t.bigBlindPosition = t.playerAfter(t.lastBigBlindPosition)
+	if headsUp {
+		t.smallBlindPosition = t.playerBefore(t.bigBlindPosition)
+		t.buttonPosition = t.smallBlindPosition
+		return
+	}
+
+	t.smallBlindPosition = t.lastBigBlindPosition
+	t.buttonPosition = t.lastSmallBlindPosition
+
+	// coming out of heads up, or after players moved, the last small blind may no longer be behind the small blind
+	if t.buttonPosition == t.smallBlindPosition || t.buttonPosition == t.bigBlindPosition ||
+		seatPassed(t.buttonPosition, t.smallBlindPosition, t.bigBlindPosition, t.maxPlayers) {
+		t.buttonPosition = t.playerBefore(t.smallBlindPosition)
+	}
+}
+
+// handPlayerAt returns the player at the position if they are in the current hand, nil otherwise
+func (t *Table) handPlayerAt(position int) *player.Player {
+	if position < 0 {
+		return nil
+	}
+	if p := t.positions[position]; p != nil && p.InList(t.currentHandPlayers) {
+		return p
+	}
+	return nil
+}
+
+// postAntes takes the ante from every player in the hand, capped at their stack
+// With a big blind ante only the big blind posts, after the big blind itself, see postBigBlindAnte.
+func (t *Table) postAntes() {
+	if t.ante <= 0 || t.config.BigBlindAnte {
+		return
+	}
+
+	for _, p := range t.CurrentHandPlayers() {
+		t.postAnte(p, t.ante)
+	}
+}
+
+// postBigBlindAnte takes the ante for the whole table from the big blind, the ante times the players dealt in
+// It is posted after the big blind, so a short big blind pays the blind first.
+func (t *Table) postBigBlindAnte() {
+	if t.ante <= 0 || !t.config.BigBlindAnte || t.bigBlindPlayer == nil {
+		return
+	}
+	t.postAnte(t.bigBlindPlayer, t.ante*int64(t.NumCurrentHandPlayers()))
+}
+
+// postAnte moves the ante from the player's stack into the pot, capped at the stack
+// Antes are dead money and do not count towards the bets of the round.
+func (t *Table) postAnte(p *player.Player, ante int64) {
+	ante = min64(ante, p.Money().Stack())
+	if ante == 0 {
+		return
+	}
+
+	t.setStack(p, p.Money().Stack()-ante, ledger.Pot(t.ID), "ante")
+	p.GoAllIn(p.Money().Stack() == 0)
+	t.pot.Add(p.ID, ante, p.AllIn())
+	p.SetLastAction(actions.ActionPostAnte, ante)
+	t.recordAction(p, handhistory.EventAnte, ante)
+}
+
+// setStraddle opts the player in or out of straddling whenever they are under the gun
+func (t *Table) setStraddle(p *player.Player, on bool) error {
+	if t.tournament != nil || !t.config.Straddle {
+		return fmt.Errorf("straddles are not allowed at this table")
+	}
+
+	if on {
+		t.straddlers[p.ID] = true
+	} else {
+		delete(t.straddlers, p.ID)
+	}
+	return nil
+}
+
+// straddleAmount returns the size of a straddle, twice the big blind
+func (t *Table) straddleAmount() int64 {
+	return t.bigBlind * 2
+}
+
+// postStraddle posts a straddle of twice the big blind for the player under the gun, if they opted in
+// The straddle is a live bet: it sets the bet to call, and the straddler acts last before the flop.
+// There are no straddles heads up, and only a full straddle can be posted.
+func (t *Table) postStraddle() {
+	t.straddled = false
+
+	p := t.handPlayerAt(t.currentTurn)
+	if p == nil || !t.straddlers[p.ID] || t.NumCurrentHandPlayers() < 3 {
+		return
+	}
+
+	straddle := t.straddleAmount()
+	if p.Money().Stack() < straddle {
+		t.l.Infof("[%v] cannot cover the straddle ($%v)", p.Name, straddle)
+		return
+	}
+
+	t.l.Infof("[%v] straddling $%v...", p.Name, straddle)
+	if err := t.postBlind(p, straddle, actions.ActionStraddle, handhistory.EventStraddle); err != nil {
+		t.l.Error(err)
+		return
+	}
+	t.straddled = true
+	t.advancePlayer()
+}
@@ -0,0 +1,163 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+)
+
+func TestSetBlindPositions(t *testing.T) {
+	tests := []struct {
+		name string
+		// seats of the players in the hand
+		seats []int
+		// button, small and big blind of the last hand, -1 before the first hand
+		lastButton, lastSB, lastBB int
+
+		wantButton, wantSB, wantBB int
+	}{
+		{
+			name:  "first hand",
+			seats: []int{0, 2, 4}, lastButton: -1, lastSB: -1, lastBB: -1,
+			wantButton: 0, wantSB: 2, wantBB: 4,
+		},
+		{
+			name:  "first hand heads up",
+			seats: []int{1, 3}, lastButton: -1, lastSB: -1, lastBB: -1,
+			wantButton: 1, wantSB: 1, wantBB: 3,
+		},
+		{
+			name:  "everyone moves one seat",
+			seats: []int{0, 1, 2, 3}, lastButton: 0, lastSB: 1, lastBB: 2,
+			wantButton: 1, wantSB: 2, wantBB: 3,
+		},
+		{
+			name:  "big blind left, small blind is dead",
+			seats: []int{0, 1, 3}, lastButton: 0, lastSB: 1, lastBB: 2,
+			wantButton: 1, wantSB: 2, wantBB: 3,
+		},
+		{
+			name:  "small blind left, button is dead",
+			seats: []int{0, 2, 3}, lastButton: 0, lastSB: 1, lastBB: 2,
+			wantButton: 1, wantSB: 2, wantBB: 3,
+		},
+		{
+			name:  "heads up, the button posts the small blind",
+			seats: []int{0, 3}, lastButton: 0, lastSB: 0, lastBB: 3,
+			wantButton: 3, wantSB: 3, wantBB: 0,
+		},
+		{
+			name:  "out of heads up, the last small blind posts the big blind",
+			seats: []int{0, 1, 3}, lastButton: 0, lastSB: 0, lastBB: 3,
+			wantButton: 1, wantSB: 3, wantBB: 0,
+		},
+		{
+			name:  "out of heads up, new player behind the button",
+			seats: []int{0, 3, 5}, lastButton: 3, lastSB: 3, lastBB: 0,
+			wantButton: 5, wantSB: 0, wantBB: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := New(make(chan ActionRequest), DefaultTableConfig())
+			for _, seat := range tt.seats {
+				p := player.New(users.User{Username: fmt.Sprintf("p%d", seat)})
+				tbl.positions[seat] = p
+				p.TablePosition = seat
+				tbl.AddCurrentHandPlayer(p)
+			}
+			tbl.buttonPosition = tt.lastButton
+			tbl.lastSmallBlindPosition, tbl.lastBigBlindPosition = tt.lastSB, tt.lastBB
+
+			tbl.setBlindPositions()
+
+			if tbl.buttonPosition != tt.wantButton || tbl.smallBlindPosition != tt.wantSB || tbl.bigBlindPosition != tt.wantBB {
+				t.Errorf("button, small blind, big blind = %v, %v, %v; want %v, %v, %v",
+					tbl.buttonPosition, tbl.smallBlindPosition, tbl.bigBlindPosition, tt.wantButton, tt.wantSB, tt.wantBB)
+			}
+		})
+	}
+}
+
+// seatHandPlayers seats n players with the stack in seats 0.. and deals them into the hand
+func seatHandPlayers(tbl *Table, n int, stack int64) []*player.Player {
+	var players []*player.Player
+	for seat := 0; seat < n; seat++ {
+		p := player.New(users.User{Username: fmt.Sprintf("p%d", seat)})
+		p.Money().SetStack(stack)
+		tbl.positions[seat] = p
+		p.TablePosition = seat
+		tbl.AddCurrentHandPlayer(p)
+		players = append(players, p)
+	}
+	return players
+}
+
+func TestPostBigBlindAnte(t *testing.T) {
+	c := DefaultTableConfig()
+	c.Ante = 2
+	c.BigBlindAnte = true
+	tbl := New(make(chan ActionRequest), c)
+	players := seatHandPlayers(tbl, 4, 1000)
+
+	tbl.bigBlindPlayer = players[2]
+	if err := tbl.postBlind(players[2], tbl.bigBlind, actions.ActionPostBigBlind, handhistory.EventBigBlind); err != nil {
+		t.Fatalf("postBlind() = %v", err)
+	}
+	tbl.postAntes()
+	tbl.postBigBlindAnte()
+
+	// the big blind posts the ante of all four players dealt in
+	if got, want := tbl.pot.GetTotal(), tbl.bigBlind+4*c.Ante; got != want {
+		t.Errorf("pot = %v, want %v", got, want)
+	}
+	if got, want := players[2].Money().Stack(), 1000-tbl.bigBlind-4*c.Ante; got != want {
+		t.Errorf("big blind stack = %v, want %v", got, want)
+	}
+	if got := players[0].Money().Stack(); got != 1000 {
+		t.Errorf("other stack = %v, want 1000", got)
+	}
+}
+
+func TestStraddleMinRaise(t *testing.T) {
+	c := DefaultTableConfig()
+	c.Straddle = true
+	tbl := New(make(chan ActionRequest), c)
+	players := seatHandPlayers(tbl, 5, 1000)
+
+	tbl.smallBlindPosition, tbl.bigBlindPosition = 0, 1
+	if err := tbl.postBlind(players[0], tbl.smallBlind, actions.ActionPostSmallBlind, handhistory.EventSmallBlind); err != nil {
+		t.Fatalf("postBlind() = %v", err)
+	}
+	if err := tbl.postBlind(players[1], tbl.bigBlind, actions.ActionPostBigBlind, handhistory.EventBigBlind); err != nil {
+		t.Fatalf("postBlind() = %v", err)
+	}
+
+	// the player under the gun straddles
+	tbl.currentTurn = 2
+	if err := tbl.setStraddle(players[2], true); err != nil {
+		t.Fatalf("setStraddle() = %v", err)
+	}
+	tbl.postStraddle()
+	if !tbl.straddled || tbl.currentTurn != 3 {
+		t.Fatalf("straddled = %v, turn = %v; want a straddle and the turn after it", tbl.straddled, tbl.currentTurn)
+	}
+
+	tbl.State = tbl.playingPreFlopState
+	if err := tbl.State.Init(); err != nil {
+		t.Fatalf("Init() = %v", err)
+	}
+
+	// the smallest re-raise over a straddle of two big blinds is to four big blinds
+	limits := tbl.betLimits(players[3])
+	if got, want := limits.Call, tbl.straddleAmount(); got != want {
+		t.Errorf("Call = %v, want %v", got, want)
+	}
+	if got, want := limits.MinRaise, 4*tbl.bigBlind; got != want {
+		t.Errorf("MinRaise = %v, want %v", got, want)
+	}
+}
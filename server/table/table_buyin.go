@@ -0,0 +1,151 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/dustin/go-humanize"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// playerBuyin is a top-up waiting for the current hand to finish, and the auto-rebuy setting of a player
+type playerBuyin struct {
+	pending int64
+
+	// refill the stack to rebuyTo whenever it drops below rebuyBelow, off if rebuyTo is 0
+	rebuyBelow, rebuyTo int64
+}
+
+// buyinRange returns the smallest and largest stack a player can buy in for
+func (t *Table) buyinRange() (min, max int64) {
+	if t.tournament != nil {
+		return t.buyinAmount, t.buyinAmount
+	}
+	return t.config.MinBuyin * t.bigBlind, t.config.MaxBuyin * t.bigBlind
+}
+
+// playerBuyin returns the buyin info of the player, creating it if needed
+func (t *Table) playerBuyin(p *player.Player) *playerBuyin {
+	b, ok := t.buyins[p.ID]
+	if !ok {
+		b = &playerBuyin{}
+		t.buyins[p.ID] = b
+	}
+	return b
+}
+
+// buyin moves amount from the player's bank to the stack, 0 buys in for the table maximum
+// A player in the current hand tops up once the hand is done.
+func (t *Table) buyin(p *player.Player, amount int64) error {
+	if t.tournament != nil {
+		return t.tournamentBuyin(p)
+	}
+
+	min, max := t.buyinRange()
+	stack := p.Money().Stack() + t.playerBuyin(p).pending
+	bank := p.Money().Bank()
+
+	if amount == 0 {
+		amount = max - stack
+		if amount > bank && bank > 0 {
+			amount = bank
+		}
+	}
+
+	switch {
+	case amount < 0:
+		return fmt.Errorf("buyin cannot be < 0 (have: %v)", amount)
+	case amount == 0:
+		return fmt.Errorf("stack is already at the table maximum ($%v)", humanize.Comma(max))
+	case stack+amount > max:
+		return fmt.Errorf("a buyin of $%v puts the stack over the table maximum ($%v)", humanize.Comma(amount), humanize.Comma(max))
+	case stack == 0 && amount < min:
+		return fmt.Errorf("table buyin is $%v to $%v, asked for: $%v", humanize.Comma(min), humanize.Comma(max), humanize.Comma(amount))
+	case amount > bank:
+		return fmt.Errorf("buyin is [$%v], player has: $%v", humanize.Comma(amount), humanize.Comma(bank))
+	}
+
+	if p.InList(t.currentHandPlayers) {
+		t.playerBuyin(p).pending += amount
+		t.l.Infof("[%v] tops up $%v after the current hand", p.Name, humanize.Comma(amount))
+		return nil
+	}
+
+	return t.moveToStack(p, amount, "buyin")
+}
+
+// moveToStack moves amount from the player's bank to the stack
+func (t *Table) moveToStack(p *player.Player, amount int64, memo string) error {
+	stack := p.Money().Stack() + amount
+	if err := t.setBank(p, p.Money().Bank()-amount, ledger.Stack(p.ID), memo); err != nil {
+		return err
+	}
+	// recorded in the ledger with the bank
+	p.Money().SetStack(stack)
+
+	t.l.Infof("[%v] bought in for $%v (stack = %v)", p.Name, humanize.Comma(amount), humanize.Comma(stack))
+	p.Stats.ActionInc(actions.ActionBuyIn)
+	return nil
+}
+
+// setAutoRebuy sets the auto-rebuy of the player, a nil or zero setting turns it off
+func (t *Table) setAutoRebuy(p *player.Player, r *ppb.AutoRebuy) error {
+	if t.tournament != nil {
+		return fmt.Errorf("there is no rebuy in a tournament")
+	}
+
+	b := t.playerBuyin(p)
+	if r.GetTo() == 0 {
+		b.rebuyBelow, b.rebuyTo = 0, 0
+		return nil
+	}
+
+	min, max := t.buyinRange()
+	switch {
+	case r.GetTo() < min || r.GetTo() > max:
+		return fmt.Errorf("auto-rebuy must refill the stack to between $%v and $%v (have: $%v)", humanize.Comma(min), humanize.Comma(max), humanize.Comma(r.GetTo()))
+	case r.GetBelow() <= 0 || r.GetBelow() > r.GetTo():
+		return fmt.Errorf("auto-rebuy threshold must be between $1 and $%v (have: $%v)", humanize.Comma(r.GetTo()), humanize.Comma(r.GetBelow()))
+	}
+
+	b.rebuyBelow, b.rebuyTo = r.GetBelow(), r.GetTo()
+	t.l.Infof("[%v] auto-rebuy to $%v below $%v", p.Name, humanize.Comma(b.rebuyTo), humanize.Comma(b.rebuyBelow))
+	return nil
+}
+
+// applyBuyins moves the pending top-ups and auto-rebuys to the stacks, called between hands
+func (t *Table) applyBuyins() {
+	for _, p := range t.PresentPlayers() {
+		b, ok := t.buyins[p.ID]
+		if !ok {
+			continue
+		}
+
+		amount := b.pending
+		b.pending = 0
+		memo := "top up"
+
+		stack := p.Money().Stack()
+		if b.rebuyTo > 0 && stack+amount < b.rebuyBelow {
+			amount = b.rebuyTo - stack
+			memo = "auto rebuy"
+		}
+		// the stack may have grown since the top up was asked for
+		if _, max := t.buyinRange(); stack+amount > max {
+			amount = max - stack
+		}
+		if amount > p.Money().Bank() {
+			amount = p.Money().Bank()
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		if err := t.moveToStack(p, amount, memo); err != nil {
+			t.l.Errorf("[%v] %v failed: %v", p.Name, memo, err)
+		}
+	}
+}
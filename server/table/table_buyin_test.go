@@ -0,0 +1,132 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestBuyin(t *testing.T) {
+	// $5/$10, buyin $400 to $1,000
+	tests := []struct {
+		name      string
+		bank      int64
+		stack     int64
+		amount    int64
+		wantErr   bool
+		wantStack int64
+	}{
+		{name: "default", bank: 5000, amount: 0, wantStack: 1000},
+		{name: "default with a small bank", bank: 600, amount: 0, wantStack: 600},
+		{name: "minimum", bank: 5000, amount: 400, wantStack: 400},
+		{name: "below minimum", bank: 5000, amount: 300, wantErr: true},
+		{name: "above maximum", bank: 5000, amount: 1100, wantErr: true},
+		{name: "more than the bank", bank: 500, amount: 600, wantErr: true},
+		{name: "top up below minimum", bank: 5000, stack: 200, amount: 100, wantStack: 300},
+		{name: "top up over maximum", bank: 5000, stack: 950, amount: 100, wantErr: true},
+		{name: "top up to maximum", bank: 5000, stack: 950, amount: 0, wantStack: 1000},
+		{name: "at maximum", bank: 5000, stack: 1000, amount: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := New(make(chan ActionRequest), DefaultTableConfig())
+			p := player.New(users.User{Username: "a", Bank: tt.bank})
+			p.Money().SetStack(tt.stack)
+
+			err := tbl.buyin(p, tt.amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buyin(%v) = %v, wantErr %v", tt.amount, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := p.Money().Stack(); got != tt.wantStack {
+				t.Errorf("stack = %v, want %v", got, tt.wantStack)
+			}
+			if got, want := p.Money().Bank(), tt.bank-(tt.wantStack-tt.stack); got != want {
+				t.Errorf("bank = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyBuyins(t *testing.T) {
+	tbl := New(make(chan ActionRequest), DefaultTableConfig())
+	p := player.New(users.User{Username: "a", Bank: 5000})
+	tbl.positions[0] = p
+	p.TablePosition = 0
+
+	if err := tbl.buyin(p, 500); err != nil {
+		t.Fatalf("buyin() = %v", err)
+	}
+	if err := tbl.setAutoRebuy(p, &ppb.AutoRebuy{Below: 200, To: 800}); err != nil {
+		t.Fatalf("setAutoRebuy() = %v", err)
+	}
+
+	// a top up in the middle of a hand waits for the hand to finish
+	tbl.AddCurrentHandPlayer(p)
+	if err := tbl.buyin(p, 100); err != nil {
+		t.Fatalf("buyin() = %v", err)
+	}
+	if got := p.Money().Stack(); got != 500 {
+		t.Errorf("stack during the hand = %v, want 500", got)
+	}
+
+	tbl.ClearCurrentHandPlayers()
+	tbl.applyBuyins()
+	if got := p.Money().Stack(); got != 600 {
+		t.Errorf("stack after the top up = %v, want 600", got)
+	}
+
+	// the stack drops below the threshold
+	p.Money().SetStack(150)
+	tbl.applyBuyins()
+	if got := p.Money().Stack(); got != 800 {
+		t.Errorf("stack after the auto rebuy = %v, want 800", got)
+	}
+
+	// a top up never takes a stack that grew during the hand over the table maximum
+	tbl.AddCurrentHandPlayer(p)
+	if err := tbl.buyin(p, 200); err != nil {
+		t.Fatalf("buyin() = %v", err)
+	}
+	p.Money().SetStack(950)
+	bank := p.Money().Bank()
+
+	tbl.ClearCurrentHandPlayers()
+	tbl.applyBuyins()
+	if got := p.Money().Stack(); got != 1000 {
+		t.Errorf("stack after a top up past a won pot = %v, want 1000", got)
+	}
+	if got := p.Money().Bank(); got != bank-50 {
+		t.Errorf("bank after a top up past a won pot = %v, want %v", got, bank-50)
+	}
+
+	if err := tbl.setAutoRebuy(p, &ppb.AutoRebuy{Below: 200, To: 2000}); err == nil {
+		t.Errorf("setAutoRebuy() over the table maximum = nil, want error")
+	}
+}
+
+func TestBuyinSaveFails(t *testing.T) {
+	tbl := New(make(chan ActionRequest), DefaultTableConfig())
+	// the user is not in the store, saving the bank fails
+	p := player.New(users.User{Username: "not-a-user", Bank: 5000})
+	p.PersistBank(users.NewMemoryStore())
+
+	if err := tbl.buyin(p, 500); err == nil {
+		t.Fatalf("buyin() with a failed save = nil, want error")
+	}
+	if got := p.Money().Bank(); got != 5000 {
+		t.Errorf("bank = %v, want 5000", got)
+	}
+	if got := p.Money().Stack(); got != 0 {
+		t.Errorf("stack = %v, want 0", got)
+	}
+	if txs := Ledger().Audit(ledger.Query{TableID: tbl.ID}); len(txs) != 0 {
+		t.Errorf("ledger recorded %v for a failed buyin, want nothing", txs)
+	}
+}
@@ -0,0 +1,201 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// TableConfig configures a cash table
+type TableConfig struct {
+	// Name is the table name, a random name is picked if empty
+	Name string
+
+	Variant          ppb.GameVariant
+	BettingStructure ppb.BettingStructure
+
+	SmallBlind, BigBlind int64
+	// Ante is posted by every player before the blinds
+	// With BigBlindAnte the big blind alone posts the antes of every player dealt in.
+	Ante         int64
+	BigBlindAnte bool
+	// Straddle lets the player under the gun post a voluntary blind of twice the big blind
+	Straddle bool
+
+	MinPlayers, MaxPlayers int
+
+	// MinBuyin and MaxBuyin is the buyin range, in big blinds
+	MinBuyin, MaxBuyin int64
+
+	// PlayerTimeout is how long to wait for a player to act
+	PlayerTimeout time.Duration
+	// TimeBank is the extra time a player gets once PlayerTimeout runs out, over the whole session
+	// It is refilled by TimeBankRefill every TimeBankRefillHands hands, up to TimeBank.
+	TimeBank            time.Duration
+	TimeBankRefill      time.Duration
+	TimeBankRefillHands int
+	// GameWaitTimeout is how long to wait for more players before starting a hand
+	GameWaitTimeout time.Duration
+
+	// Private tables are not listed in the lobby, and players are never seated there automatically
+	// Players join a private table with the password, or an invite.
+	Private  bool
+	Password string
+	Invited  []string
+	// Owner is the username of the player that created the table
+	Owner string
+
+	// DisconnectGrace is how long the seat of a disconnected player is held for them to reconnect
+	// The player is folded and cashed out once it runs out, 0 does that right away.
+	DisconnectGrace time.Duration
+
+	// IdleTimeout tears the table down once it has been empty this long, 0 keeps it forever
+	IdleTimeout time.Duration
+
+	// Clock times the table, the wall clock if nil
+	Clock clock.Clock
+	// Ledger records the money and chips moving at the table, the ledger shared by all tables if nil
+	Ledger *ledger.Ledger
+	// Seed seeds the shuffles, a seed from the clock is picked if 0
+	Seed int64
+}
+
+// DefaultTableConfig returns the config of a $5/$10 table for 7 players
+func DefaultTableConfig() TableConfig {
+	return TableConfig{
+		Variant:          ppb.GameVariant_GameVariantTexasHoldem,
+		BettingStructure: DefaultBettingStructure(ppb.GameVariant_GameVariantTexasHoldem),
+		SmallBlind:       5,
+		BigBlind:         10,
+		MinPlayers:       2,
+		MaxPlayers:       7,
+		MinBuyin:         40,
+		MaxBuyin:         100,
+		PlayerTimeout:    time.Second * 30,
+		GameWaitTimeout:  time.Second * 10,
+
+		TimeBank:            time.Second * 60,
+		TimeBankRefill:      time.Second * 10,
+		TimeBankRefillHands: 10,
+
+		DisconnectGrace: time.Minute,
+	}
+}
+
+// Validate returns an error if the config is not playable
+func (c TableConfig) Validate() error {
+	if strings.Contains(c.Name, "..") || strings.ContainsAny(c.Name, `/\`) {
+		return fmt.Errorf("table name cannot contain '..' or path separators: %q", c.Name)
+	}
+	for _, r := range c.Name {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("table name cannot contain control characters: %q", c.Name)
+		}
+	}
+	if _, ok := ppb.GameVariant_name[int32(c.Variant)]; !ok {
+		return fmt.Errorf("invalid game variant: %v", c.Variant)
+	}
+	if _, ok := ppb.BettingStructure_name[int32(c.BettingStructure)]; !ok {
+		return fmt.Errorf("invalid betting structure: %v", c.BettingStructure)
+	}
+	if c.SmallBlind <= 0 || c.BigBlind < c.SmallBlind {
+		return fmt.Errorf("invalid blinds: $%v/$%v", c.SmallBlind, c.BigBlind)
+	}
+	if c.Ante < 0 || (c.BigBlindAnte && c.Ante == 0) {
+		return fmt.Errorf("invalid ante: $%v (big blind ante: %v)", c.Ante, c.BigBlindAnte)
+	}
+	if c.MinPlayers < 2 || c.MaxPlayers < c.MinPlayers {
+		return fmt.Errorf("invalid number of players: min %v, max %v", c.MinPlayers, c.MaxPlayers)
+	}
+	if c.MaxPlayers > 10 {
+		return fmt.Errorf("table cannot seat more than 10 players (have: %v)", c.MaxPlayers)
+	}
+	if c.MinBuyin <= 0 || c.MaxBuyin < c.MinBuyin {
+		return fmt.Errorf("invalid buyin range: %v to %v big blinds", c.MinBuyin, c.MaxBuyin)
+	}
+	if c.PlayerTimeout <= 0 || c.GameWaitTimeout < 0 || c.IdleTimeout < 0 || c.DisconnectGrace < 0 {
+		return fmt.Errorf("timeouts cannot be < 0")
+	}
+	if c.TimeBank < 0 || c.TimeBankRefill < 0 || c.TimeBankRefillHands < 0 {
+		return fmt.Errorf("time bank cannot be < 0")
+	}
+	if !c.Private && (c.Password != "" || len(c.Invited) > 0) {
+		return fmt.Errorf("only private tables have a password or invites")
+	}
+	return nil
+}
+
+// TableConfigFromProto returns the config requested by a client, unset fields are taken from defaults
+func TableConfigFromProto(in *ppb.TableConfig, defaults TableConfig) TableConfig {
+	c := defaults
+
+	if in.GetName() != "" {
+		c.Name = in.GetName()
+	}
+	if in != nil && in.Variant != nil {
+		c.Variant = in.GetVariant()
+		c.BettingStructure = DefaultBettingStructure(c.Variant)
+	}
+	if in != nil && in.BettingStructure != nil {
+		c.BettingStructure = in.GetBettingStructure()
+	}
+
+	if in.GetBigBlind() > 0 {
+		c.SmallBlind = in.GetSmallBlind()
+		c.BigBlind = in.GetBigBlind()
+	}
+	c.Ante = in.GetAnte()
+	c.BigBlindAnte = in.GetBigBlindAnte()
+	c.Straddle = in.GetStraddle()
+	if in.GetMinPlayers() > 0 {
+		c.MinPlayers = int(in.GetMinPlayers())
+	}
+	if in.GetMaxPlayers() > 0 {
+		c.MaxPlayers = int(in.GetMaxPlayers())
+	}
+	if in.GetMinBuyinBigBlinds() > 0 {
+		c.MinBuyin = in.GetMinBuyinBigBlinds()
+	}
+	if in.GetMaxBuyinBigBlinds() > 0 {
+		c.MaxBuyin = in.GetMaxBuyinBigBlinds()
+	}
+	if in.GetPlayerTimeoutSec() > 0 {
+		c.PlayerTimeout = time.Duration(in.GetPlayerTimeoutSec()) * time.Second
+	}
+	if in.GetGameWaitTimeoutSec() > 0 {
+		c.GameWaitTimeout = time.Duration(in.GetGameWaitTimeoutSec()) * time.Second
+	}
+	if in.GetTimeBankSec() > 0 {
+		c.TimeBank = time.Duration(in.GetTimeBankSec()) * time.Second
+	}
+	if in.GetTimeBankRefillSec() > 0 {
+		c.TimeBankRefill = time.Duration(in.GetTimeBankRefillSec()) * time.Second
+	}
+	if in.GetTimeBankRefillHands() > 0 {
+		c.TimeBankRefillHands = int(in.GetTimeBankRefillHands())
+	}
+	if in.GetDisconnectGraceSec() > 0 {
+		c.DisconnectGrace = time.Duration(in.GetDisconnectGraceSec()) * time.Second
+	}
+	c.Private = in.GetPrivate()
+	c.Password = in.GetPassword()
+	c.Invited = in.GetInvited()
+
+	return c
+}
+
+// Private returns true if the table is private
+func (t *Table) Private() bool {
+	return t.config.Private
+}
+
+// IdleTimeout returns how long the table may stay empty before it is torn down, 0 if never
+func (t *Table) IdleTimeout() time.Duration {
+	return t.config.IdleTimeout
+}
@@ -0,0 +1,147 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestTableConfigFromProto(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults func(c *TableConfig)
+		in       *ppb.TableConfig
+		want     func(c *TableConfig)
+		wantErr  bool
+	}{
+		{
+			name: "defaults",
+			in:   nil,
+			want: func(c *TableConfig) {},
+		},
+		{
+			name: "omaha at $25/$50",
+			in: &ppb.TableConfig{
+				Name:             "home game",
+				Variant:          ppb.GameVariant_GameVariantPotLimitOmaha.Enum(),
+				BettingStructure: ppb.BettingStructure_BettingStructurePotLimit.Enum(),
+				SmallBlind:       25,
+				BigBlind:         50,
+				MaxPlayers:       6,
+				PlayerTimeoutSec: 15,
+				TimeBankSec:      120,
+				Private:          true,
+				Password:         "secret",
+				Ante:             50,
+				BigBlindAnte:     true,
+				Straddle:         true,
+			},
+			want: func(c *TableConfig) {
+				c.Name = "home game"
+				c.Variant = ppb.GameVariant_GameVariantPotLimitOmaha
+				c.BettingStructure = ppb.BettingStructure_BettingStructurePotLimit
+				c.SmallBlind, c.BigBlind = 25, 50
+				c.MaxPlayers = 6
+				c.PlayerTimeout = time.Second * 15
+				c.TimeBank = time.Second * 120
+				c.Private = true
+				c.Password = "secret"
+				c.Ante, c.BigBlindAnte = 50, true
+				c.Straddle = true
+			},
+		},
+		{
+			name: "unset variant keeps the server defaults",
+			defaults: func(c *TableConfig) {
+				c.Variant = ppb.GameVariant_GameVariantPotLimitOmaha
+				c.BettingStructure = ppb.BettingStructure_BettingStructurePotLimit
+			},
+			in:   &ppb.TableConfig{Name: "home game"},
+			want: func(c *TableConfig) { c.Name = "home game" },
+		},
+		{
+			name: "omaha without a betting structure",
+			in:   &ppb.TableConfig{Variant: ppb.GameVariant_GameVariantPotLimitOmaha.Enum()},
+			want: func(c *TableConfig) {
+				c.Variant = ppb.GameVariant_GameVariantPotLimitOmaha
+				c.BettingStructure = ppb.BettingStructure_BettingStructurePotLimit
+			},
+		},
+		{
+			name: "no-limit omaha",
+			in: &ppb.TableConfig{
+				Variant:          ppb.GameVariant_GameVariantPotLimitOmaha.Enum(),
+				BettingStructure: ppb.BettingStructure_BettingStructureNoLimit.Enum(),
+			},
+			want: func(c *TableConfig) {
+				c.Variant = ppb.GameVariant_GameVariantPotLimitOmaha
+				c.BettingStructure = ppb.BettingStructure_BettingStructureNoLimit
+			},
+		},
+		{
+			name:    "blinds out of order",
+			in:      &ppb.TableConfig{SmallBlind: 50, BigBlind: 25},
+			wantErr: true,
+		},
+		{
+			name:    "name escaping the hand history directory",
+			in:      &ppb.TableConfig{Name: "../../x"},
+			wantErr: true,
+		},
+		{
+			name:    "name with a path separator",
+			in:      &ppb.TableConfig{Name: `home\game`},
+			wantErr: true,
+		},
+		{
+			name:    "name with a control character",
+			in:      &ppb.TableConfig{Name: "home\ngame"},
+			wantErr: true,
+		},
+		{
+			name:    "password at a public table",
+			in:      &ppb.TableConfig{Password: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "big blind ante without an ante",
+			in:      &ppb.TableConfig{BigBlindAnte: true},
+			wantErr: true,
+		},
+		{
+			name:    "too many seats",
+			in:      &ppb.TableConfig{MaxPlayers: 11},
+			wantErr: true,
+		},
+		{
+			name:    "buyin range out of order",
+			in:      &ppb.TableConfig{MinBuyinBigBlinds: 200, MaxBuyinBigBlinds: 100},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaults := DefaultTableConfig()
+			if tt.defaults != nil {
+				tt.defaults(&defaults)
+			}
+			got := TableConfigFromProto(tt.in, defaults)
+
+			err := got.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			want := defaults
+			tt.want(&want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("TableConfigFromProto() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
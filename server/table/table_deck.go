@@ -0,0 +1,35 @@
+package table
+
+import (
+	"math/rand"
+
+	"github.com/DanTulovsky/deck"
+)
+
+// shuffledDeck returns a new deck for the hand, shuffled with a seed drawn from the random source of the table
+// The seed is recorded in the hand history, so the deal can be replayed.
+func (t *Table) shuffledDeck() *deck.Deck {
+	t.deckSeed = t.rng.Int63()
+	if t.replay != nil {
+		t.deckSeed = t.replay.seed
+	}
+	return deckFromSeed(t.deckSeed)
+}
+
+// deckFromSeed returns a new deck shuffled with the seed
+// The deck package reseeds the global source from the time on every shuffle, so it cannot be repeated.
+func deckFromSeed(seed int64) *deck.Deck {
+	d := deck.NewDeck()
+
+	var cards []deck.Card
+	for !d.IsEmpty() {
+		c, _ := d.Next()
+		cards = append(cards, c)
+	}
+	rand.New(rand.NewSource(seed)).Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+
+	for _, c := range cards {
+		d.Return(c)
+	}
+	return d
+}
@@ -0,0 +1,181 @@
+package table
+
+import (
+	"flag"
+	"time"
+
+	"github.com/DanTulovsky/deck"
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+var (
+	snapshotInterval = flag.Duration("table_snapshot_interval", time.Second*5, "how often players are sent the table state when nothing changes")
+)
+
+const (
+	// number of recent events kept to resend to players that fell behind
+	eventLogSize = 256
+)
+
+// turnKey identifies a turn of a player, to publish each turn once
+type turnKey struct {
+	playerID id.PlayerID
+	turn     int64
+}
+
+// publish numbers the event and queues it for the players
+func (t *Table) publish(e *ppb.TableEvent) {
+	t.eventSeq++
+	e.Seq = t.eventSeq
+
+	t.events = append(t.events, e)
+	if len(t.events) > eventLogSize {
+		t.events = t.events[len(t.events)-eventLogSize:]
+	}
+}
+
+// publishHandEvent publishes an event recorded in the hand history
+func (t *Table) publishHandEvent(e handhistory.Event) {
+	te := &ppb.TableEvent{
+		PlayerID:  e.PlayerID.String(),
+		Amount:    e.Amount,
+		AllIn:     e.AllIn,
+		GameState: e.Street,
+	}
+
+	switch e.Type {
+	case handhistory.EventHoleCards, handhistory.EventStreet:
+		te.Type = ppb.TableEventType_TableEventCardDealt
+		te.Cards = deck.CardsToProto(e.Cards)
+	case handhistory.EventShow:
+		te.Type = ppb.TableEventType_TableEventCardsShown
+		te.Cards = deck.CardsToProto(e.Cards)
+	case handhistory.EventCollect:
+		te.Type = ppb.TableEventType_TableEventPotAwarded
+		te.Pot = int64(e.Pot)
+	case handhistory.EventUncalled:
+		// the uncalled bet is part of the stacks sent with the next update
+		return
+	default:
+		te.Type = ppb.TableEventType_TableEventActionTaken
+		te.Action = eventAction(e.Type)
+	}
+
+	t.publish(te)
+}
+
+// eventAction returns the player action of a recorded betting event
+func eventAction(e handhistory.EventType) ppb.PlayerAction {
+	switch e {
+	case handhistory.EventAnte:
+		return ppb.PlayerAction_PlayerActionPostAnte
+	case handhistory.EventSmallBlind:
+		return ppb.PlayerAction_PlayerActionPostSmallBlind
+	case handhistory.EventBigBlind:
+		return ppb.PlayerAction_PlayerActionPostBigBlind
+	case handhistory.EventDeadBlind:
+		return ppb.PlayerAction_PlayerActionPostDeadBlind
+	case handhistory.EventStraddle:
+		return ppb.PlayerAction_PlayerActionStraddle
+	case handhistory.EventFold:
+		return ppb.PlayerAction_PlayerActionFold
+	case handhistory.EventCheck:
+		return ppb.PlayerAction_PlayerActionCheck
+	case handhistory.EventCall:
+		return ppb.PlayerAction_PlayerActionCall
+	case handhistory.EventBet, handhistory.EventRaise:
+		return ppb.PlayerAction_PlayerActionBet
+	}
+	return ppb.PlayerAction_PlayerActionNone
+}
+
+// publishTurn publishes the start of a player's turn, once per turn
+func (t *Table) publishTurn() {
+	p := t.State.WaitingTurnPlayer()
+	if p == nil {
+		t.lastTurn = turnKey{}
+		return
+	}
+
+	if turn := (turnKey{playerID: p.ID, turn: p.CurrentTurn}); turn != t.lastTurn {
+		t.lastTurn = turn
+		t.publish(&ppb.TableEvent{
+			Type:      ppb.TableEventType_TableEventTurnStarted,
+			PlayerID:  p.ID.String(),
+			GameState: t.State.Name(),
+		})
+	}
+}
+
+// eventsSince returns the events after seq for the player, false if some of them are no longer kept
+// Hole cards dealt to other players are left out.
+func (t *Table) eventsSince(p *player.Player, seq int64) ([]*ppb.TableEvent, bool) {
+	if seq == t.eventSeq {
+		return nil, true
+	}
+	if len(t.events) == 0 || t.events[0].Seq > seq+1 {
+		return nil, false
+	}
+
+	var events []*ppb.TableEvent
+	for _, e := range t.events[seq+1-t.events[0].Seq:] {
+		if e.Type == ppb.TableEventType_TableEventCardDealt && e.PlayerID != "" && e.PlayerID != p.ID.String() {
+			e = &ppb.TableEvent{Seq: e.Seq, Type: e.Type, PlayerID: e.PlayerID, GameState: e.GameState}
+		}
+		events = append(events, e)
+	}
+	return events, true
+}
+
+// requestSnapshot sends the player a fresh snapshot of the table with the next update
+func (t *Table) requestSnapshot(p *player.Player) {
+	delete(t.delivered, p.ID)
+}
+
+// sendUpdateToPlayers sends each player the events they have not seen yet, with the table state after them
+// Nothing is sent while the table does not change, except a snapshot every snapshotInterval. An update the player's
+// channel cannot take right away is sent on a later tick, and players too far behind get a snapshot instead.
+func (t *Table) sendUpdateToPlayers() {
+	t.publishTurn()
+
+	now := t.clock.Now()
+	heartbeat := now.Sub(t.lastSnapshot) >= *snapshotInterval
+	if heartbeat {
+		t.lastSnapshot = now
+	}
+	changed := t.changed
+	t.changed = false
+
+	// note that this sends updates to all PresentPlayers, not just the ones playing a hand
+	for _, p := range t.PresentPlayers() {
+		if p.CommChannel == nil {
+			t.l.Debugf("player [%v] has nil comm channel", p.Name)
+			continue
+		}
+
+		seq, seen := t.delivered[p.ID]
+		if seen && seq == t.eventSeq && !changed && !heartbeat {
+			continue
+		}
+
+		in := t.gameDataProto(p)
+		in.Seq = t.eventSeq
+		if seen {
+			in.Events, seen = t.eventsSince(p, seq)
+		}
+		in.Snapshot = !seen
+
+		t.l.Debugf("Sending update to %v", p.Name)
+		select {
+		case p.CommChannel <- actions.NewGameData(in):
+			t.delivered[p.ID] = t.eventSeq
+		default:
+			t.l.Debugf("player [%v] is busy, sending the update later", p.Name)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestSendUpdateToPlayers(t *testing.T) {
+	tbl := New(make(chan ActionRequest), DefaultTableConfig())
+
+	p := player.New(users.User{Username: "a"})
+	tbl.positions[0] = p
+	p.TablePosition = 0
+	p.CommChannel = make(chan actions.GameData, 1)
+
+	other := player.New(users.User{Username: "b"})
+
+	receive := func() *ppb.GameData {
+		select {
+		case in := <-p.CommChannel:
+			return in.Data
+		default:
+			return nil
+		}
+	}
+
+	tbl.sendUpdateToPlayers()
+	if in := receive(); in == nil || !in.GetSnapshot() {
+		t.Fatalf("first update = %v, want a snapshot", in)
+	}
+
+	tbl.sendUpdateToPlayers()
+	if in := receive(); in != nil {
+		t.Errorf("update sent without changes: %v", in)
+	}
+
+	tbl.publish(&ppb.TableEvent{Type: ppb.TableEventType_TableEventActionTaken, PlayerID: other.ID.String()})
+	tbl.sendUpdateToPlayers()
+
+	// the channel is busy, the next event is sent along with it later
+	tbl.publish(&ppb.TableEvent{Type: ppb.TableEventType_TableEventCardDealt, PlayerID: other.ID.String(), Cards: []*ppb.Card{{}}})
+	tbl.sendUpdateToPlayers()
+
+	if in := receive(); in == nil || len(in.GetEvents()) != 1 || in.GetSeq() != 1 {
+		t.Fatalf("update = %v, want event 1", in)
+	}
+	tbl.sendUpdateToPlayers()
+
+	in := receive()
+	if in == nil || in.GetSnapshot() || len(in.GetEvents()) != 1 || in.GetEvents()[0].GetSeq() != 2 {
+		t.Fatalf("update = %v, want event 2", in)
+	}
+	if len(in.GetEvents()[0].GetCards()) != 0 {
+		t.Errorf("hole cards of another player sent: %v", in.GetEvents()[0])
+	}
+
+	// players that fell too far behind get a snapshot
+	for i := 0; i < eventLogSize+1; i++ {
+		tbl.publish(&ppb.TableEvent{Type: ppb.TableEventType_TableEventStateChanged})
+	}
+	tbl.sendUpdateToPlayers()
+	if in := receive(); in == nil || !in.GetSnapshot() || len(in.GetEvents()) != 0 {
+		t.Errorf("update = %v, want a snapshot", in)
+	}
+}
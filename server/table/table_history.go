@@ -0,0 +1,204 @@
+package table
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+var (
+	handHistoryDir = flag.String("hand_history_dir", "", "if set, every hand is appended in the PokerStars format (<table>-<id>.txt), and as JSON that can be replayed (<table>-<id>.json), to files per table in this directory")
+)
+
+const (
+	// number of hands kept in memory at each table
+	handHistorySize = 100
+)
+
+// record records the event in the hand, and publishes it to the players
+func (t *Table) record(h *handhistory.Hand, e handhistory.Event) {
+	h.Record(e)
+	t.publishHandEvent(e)
+}
+
+// HandHistory returns the most recent hands played at the table
+func (t *Table) HandHistory() *handhistory.Store {
+	return t.handHistory
+}
+
+// startHandHistory starts recording the hand with the seats, stacks and hole cards, once the cards are dealt
+func (t *Table) startHandHistory() {
+	h := handhistory.New(t.ID, t.Name, t.clock.Now())
+
+	h.Variant = t.variant
+	h.BettingStructure = t.bettingStructure.Type()
+	h.Tournament = t.tournament != nil
+	h.MaxPlayers = t.maxPlayers
+	h.SmallBlind = t.smallBlind
+	h.BigBlind = t.bigBlind
+	h.Ante = t.ante
+	h.BigBlindAnte = t.config.BigBlindAnte
+	h.Button = t.buttonPosition
+	h.Seed = t.deckSeed
+
+	for _, p := range t.CurrentHandPlayers() {
+		h.AddSeat(handhistory.Seat{
+			Position: p.TablePosition,
+			PlayerID: p.ID,
+			Name:     p.Name,
+			Stack:    p.Money().Stack(),
+		})
+	}
+
+	for _, p := range t.CurrentHandPlayers() {
+		t.record(h, handhistory.Event{
+			Type:     handhistory.EventHoleCards,
+			Street:   t.State.Name(),
+			PlayerID: p.ID,
+			Cards:    p.Hole(),
+		})
+	}
+
+	t.hand = h
+}
+
+// recordAction records a player action in the current hand
+func (t *Table) recordAction(p *player.Player, e handhistory.EventType, amount int64) {
+	if t.hand == nil {
+		return
+	}
+
+	t.record(t.hand, handhistory.Event{
+		Type:     e,
+		Street:   t.State.Name(),
+		PlayerID: p.ID,
+		Amount:   amount,
+		RaiseTo:  p.Money().BetThisRound(),
+		AllIn:    p.AllIn(),
+	})
+}
+
+// recordBet records a check, call, bet or raise; currentBet is the highest bet before this one
+func (t *Table) recordBet(p *player.Player, bet, currentBet int64) {
+	switch {
+	case bet == 0:
+		t.recordAction(p, handhistory.EventCheck, 0)
+	case p.Money().BetThisRound() <= currentBet:
+		t.recordAction(p, handhistory.EventCall, bet)
+	case currentBet == 0:
+		t.recordAction(p, handhistory.EventBet, bet)
+	default:
+		t.recordAction(p, handhistory.EventRaise, bet)
+	}
+}
+
+// recordStreet records the cards dealt to the board since the last street
+func (t *Table) recordStreet() {
+	if t.hand == nil {
+		return
+	}
+
+	t.record(t.hand, handhistory.Event{
+		Type:   handhistory.EventStreet,
+		Street: t.State.Name(),
+		Cards:  t.board.Cards()[len(t.hand.Board):],
+	})
+}
+
+// finishHandHistory records the showdown and the pot awards, and stores the hand
+func (t *Table) finishHandHistory(showdown bool) {
+	h := t.hand
+	if h == nil {
+		return
+	}
+	t.hand = nil
+
+	if showdown {
+		for _, p := range t.CurrentHandActivePlayers() {
+			e := handhistory.Event{
+				Type:     handhistory.EventShow,
+				Street:   t.State.Name(),
+				PlayerID: p.ID,
+				Cards:    p.Hole(),
+			}
+			if p.PlayerHand() != nil {
+				e.Combo = p.PlayerHand().Hand.Combo().String()
+			}
+			t.record(h, e)
+		}
+	}
+
+	awards, err := t.pot.Awards()
+	if err != nil {
+		t.l.Errorf("unable to record the pot in the hand history: %v", err)
+	}
+
+	var pot int
+	for _, a := range awards {
+		// a subpot only one player put money in is a bet nobody called
+		if a.Contributors == 1 {
+			for pid, amount := range a.Winnings {
+				t.record(h, handhistory.Event{Type: handhistory.EventUncalled, Street: t.State.Name(), PlayerID: pid, Amount: amount})
+			}
+			continue
+		}
+
+		for _, s := range h.Seats {
+			if amount := a.Winnings[s.PlayerID]; amount > 0 {
+				t.record(h, handhistory.Event{Type: handhistory.EventCollect, Street: t.State.Name(), PlayerID: s.PlayerID, Amount: amount, Pot: pot})
+			}
+		}
+		pot++
+	}
+
+	t.handHistory.Add(h)
+
+	if *handHistoryDir != "" {
+		if err := t.writeHandHistory(h); err != nil {
+			t.l.Errorf("unable to write the hand history: %v", err)
+		}
+	}
+}
+
+// writeHandHistory appends the hand to the table's hand history files
+// The JSON file keeps the deck seed and the hand number, a reported hand is replayed from it.
+func (t *Table) writeHandHistory(h *handhistory.Hand) error {
+	if err := t.appendHandHistory("txt", func(w io.Writer) error { return handhistory.WritePokerStars(w, h, "") }); err != nil {
+		return err
+	}
+	return t.appendHandHistory("json", func(w io.Writer) error { return handhistory.WriteJSON(w, h) })
+}
+
+// handHistoryFile returns the table's hand history file with the extension
+// The table ID keeps tables with the same name apart, only letters, digits, '-' and '_' of the name are kept.
+func (t *Table) handHistoryFile(ext string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, t.Name)
+
+	return filepath.Join(*handHistoryDir, fmt.Sprintf("%v-%v.%v", name, t.ID, ext))
+}
+
+// appendHandHistory appends to the table's hand history file with the extension
+func (t *Table) appendHandHistory(ext string, write func(w io.Writer) error) error {
+	f, err := os.OpenFile(t.handHistoryFile(ext), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
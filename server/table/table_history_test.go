@@ -0,0 +1,24 @@
+package table
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestHandHistoryFile(t *testing.T) {
+	defer func(d string) { *handHistoryDir = d }(*handHistoryDir)
+	*handHistoryDir = "/hands"
+
+	c := DefaultTableConfig()
+	c.Name = "home game"
+	t1 := New(make(chan ActionRequest), c)
+	t2 := New(make(chan ActionRequest), c)
+
+	f1, f2 := t1.handHistoryFile("json"), t2.handHistoryFile("json")
+	if f1 == f2 {
+		t.Errorf("tables with the same name write to the same hand history file: %v", f1)
+	}
+	if want := filepath.Join("/hands", "home_game-"+t1.ID.String()+".json"); f1 != want {
+		t.Errorf("handHistoryFile() = %v, want %v", f1, want)
+	}
+}
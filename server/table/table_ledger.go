@@ -0,0 +1,75 @@
+package table
+
+import (
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+const (
+	// number of transactions kept for audit queries
+	ledgerSize = 100000
+)
+
+// chipLedger is shared by all tables, players and their chips move between tables
+var chipLedger = ledger.New(ledgerSize)
+
+// Ledger returns the ledger of all bank, stack and pot movements, used by every table not given a ledger of its own
+func Ledger() *ledger.Ledger {
+	return chipLedger
+}
+
+// transfer records a movement of money or chips in the table's ledger
+func (t *Table) transfer(from, to ledger.Account, amount int64, memo string) {
+	if err := t.ledger.Transfer(t.ID, from, to, amount, memo); err != nil {
+		t.l.Errorf("ledger: %v", err)
+	}
+}
+
+// setBank sets the player's bank, and records the change against account in the ledger
+// Nothing changes if the bank cannot be saved.
+func (t *Table) setBank(p *player.Player, bank int64, account ledger.Account, memo string) error {
+	old := p.Money().Bank()
+	if err := p.Money().SetBank(bank); err != nil {
+		return err
+	}
+
+	switch {
+	case bank > old:
+		t.transfer(account, ledger.Bank(p.ID), bank-old, memo)
+	case bank < old:
+		t.transfer(ledger.Bank(p.ID), account, old-bank, memo)
+	}
+	return nil
+}
+
+// setStack sets the player's stack, and records the change against account in the ledger
+func (t *Table) setStack(p *player.Player, stack int64, account ledger.Account, memo string) {
+	old := p.Money().Stack()
+	p.Money().SetStack(stack)
+
+	switch {
+	case stack > old:
+		t.transfer(account, ledger.Stack(p.ID), stack-old, memo)
+	case stack < old:
+		t.transfer(ledger.Stack(p.ID), account, old-stack, memo)
+	}
+}
+
+// tournamentChips returns the account tournament chips are issued from
+func (t *Table) tournamentChips() ledger.Account {
+	return ledger.Chips(t.tournament.id)
+}
+
+// auditHand checks that the pot was paid out in full, and that the players' banks and stacks match the ledger
+// A player that busted or cashed out has a stack of 0 in the ledger, like any other balance.
+func (t *Table) auditHand() error {
+	expected := map[ledger.Account]int64{
+		ledger.Pot(t.ID): 0,
+	}
+	for _, p := range t.PresentPlayers() {
+		expected[ledger.Bank(p.ID)] = p.Money().Bank()
+		expected[ledger.Stack(p.ID)] = p.Money().Stack()
+	}
+
+	return t.ledger.Verify(expected)
+}
@@ -0,0 +1,52 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+)
+
+func TestAuditHand(t *testing.T) {
+	tests := []struct {
+		name string
+		// breaks the bookkeeping of the hand, a busts to b
+		breakHand func(a, b *player.Player)
+		wantErr   bool
+	}{
+		{name: "bust out", breakHand: func(a, b *player.Player) {}},
+		{name: "busted player keeps chips", breakHand: func(a, b *player.Player) { a.Money().SetStack(100) }, wantErr: true},
+		{name: "busted player bank changed", breakHand: func(a, b *player.Player) { a.Money().SetBank(0) }, wantErr: true},
+		{name: "winner stack zeroed", breakHand: func(a, b *player.Player) { b.Money().SetStack(0) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := New(make(chan ActionRequest), DefaultTableConfig())
+
+			a := player.New(users.User{Username: "a", Bank: 5000})
+			b := player.New(users.User{Username: "b", Bank: 5000})
+			for i, p := range []*player.Player{a, b} {
+				if err := Ledger().Transfer(id.EmptyTableID, ledger.External(), ledger.Bank(p.ID), 5000, "opening balance"); err != nil {
+					t.Fatalf("Transfer() = %v", err)
+				}
+				if err := tbl.buyin(p, 500); err != nil {
+					t.Fatalf("buyin() = %v", err)
+				}
+				tbl.positions[i] = p
+				p.TablePosition = i
+			}
+
+			// a goes all in, b calls and wins
+			tbl.setStack(a, 0, ledger.Pot(tbl.ID), "allin")
+			tbl.setStack(b, 0, ledger.Pot(tbl.ID), "call")
+			tbl.setStack(b, 1000, ledger.Pot(tbl.ID), "winnings")
+			tt.breakHand(a, b)
+
+			if err := tbl.auditHand(); (err != nil) != tt.wantErr {
+				t.Errorf("auditHand() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
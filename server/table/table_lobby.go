@@ -0,0 +1,125 @@
+package table
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+const (
+	// number of recent hands the average pot is computed over
+	recentPotsSize = 20
+)
+
+// Summary returns the lobby view of the table
+// It is safe to call from any goroutine and never waits on the table.
+func (t *Table) Summary() *ppb.TableSummary {
+	if s, ok := t.summary.Load().(*ppb.TableSummary); ok {
+		return s
+	}
+
+	// the table has not run yet
+	return &ppb.TableSummary{
+		TableID:   t.ID.String(),
+		TableName: t.Name,
+	}
+}
+
+// updateSummary publishes the lobby view of the table, called from the table goroutine
+func (t *Table) updateSummary() {
+	minBuyin, maxBuyin := t.buyinRange()
+	s := &ppb.TableSummary{
+		TableID:          t.ID.String(),
+		TableName:        t.Name,
+		Variant:          t.variant,
+		BettingStructure: t.bettingStructure.Type(),
+
+		SmallBlind: t.smallBlind,
+		BigBlind:   t.bigBlind,
+		Ante:       t.ante,
+		Buyin:      maxBuyin,
+		MinBuyin:   minBuyin,
+
+		Players:         int64(t.numPresentPlayers()),
+		MaxPlayers:      int64(t.maxPlayers),
+		AvailableToJoin: t.AvailableToJoin(),
+
+		AveragePot: t.averagePot(),
+		GameState:  t.State.Name(),
+		Tournament: t.tournament != nil,
+	}
+
+	if old, ok := t.summary.Load().(*ppb.TableSummary); ok && proto.Equal(old, s) {
+		return
+	}
+	t.summary.Store(s)
+}
+
+// recordPot keeps track of the pot of the hand just played
+func (t *Table) recordPot(pot int64) {
+	t.recentPots = append(t.recentPots, pot)
+	if len(t.recentPots) > recentPotsSize {
+		t.recentPots = t.recentPots[len(t.recentPots)-recentPotsSize:]
+	}
+}
+
+// averagePot returns the average pot of the recent hands
+func (t *Table) averagePot() int64 {
+	if len(t.recentPots) == 0 {
+		return 0
+	}
+
+	var total int64
+	for _, p := range t.recentPots {
+		total += p
+	}
+	return total / int64(len(t.recentPots))
+}
+
+// MatchesFilter returns true if the table summary matches the lobby filter
+func MatchesFilter(s *ppb.TableSummary, f *ppb.TableFilter) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.GetVariants()) > 0 {
+		found := false
+		for _, v := range f.GetVariants() {
+			if v == s.GetVariant() {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.GetBettingStructures()) > 0 {
+		found := false
+		for _, b := range f.GetBettingStructures() {
+			if b == s.GetBettingStructure() {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	switch {
+	case f.GetMinBigBlind() > 0 && s.GetBigBlind() < f.GetMinBigBlind():
+		return false
+	case f.GetMaxBigBlind() > 0 && s.GetBigBlind() > f.GetMaxBigBlind():
+		return false
+	case f.GetHideFull() && s.GetPlayers() >= s.GetMaxPlayers():
+		return false
+	case f.GetHideEmpty() && s.GetPlayers() == 0:
+		return false
+	case f.GetCashOnly() && s.GetTournament():
+		return false
+	case f.GetTournamentOnly() && !s.GetTournament():
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,54 @@
+package table
+
+import (
+	"testing"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	s := &ppb.TableSummary{
+		Variant:          ppb.GameVariant_GameVariantPotLimitOmaha,
+		BettingStructure: ppb.BettingStructure_BettingStructurePotLimit,
+		BigBlind:         20,
+		Players:          3,
+		MaxPlayers:       6,
+	}
+
+	tests := []struct {
+		name   string
+		filter *ppb.TableFilter
+		want   bool
+	}{
+		{name: "no filter", filter: nil, want: true},
+		{name: "empty filter", filter: &ppb.TableFilter{}, want: true},
+		{
+			name:   "variant",
+			filter: &ppb.TableFilter{Variants: []ppb.GameVariant{ppb.GameVariant_GameVariantTexasHoldem, ppb.GameVariant_GameVariantPotLimitOmaha}},
+			want:   true,
+		},
+		{
+			name:   "other variant",
+			filter: &ppb.TableFilter{Variants: []ppb.GameVariant{ppb.GameVariant_GameVariantTexasHoldem}},
+			want:   false,
+		},
+		{
+			name:   "other betting structure",
+			filter: &ppb.TableFilter{BettingStructures: []ppb.BettingStructure{ppb.BettingStructure_BettingStructureNoLimit}},
+			want:   false,
+		},
+		{name: "stakes in range", filter: &ppb.TableFilter{MinBigBlind: 20, MaxBigBlind: 20}, want: true},
+		{name: "stakes too low", filter: &ppb.TableFilter{MinBigBlind: 50}, want: false},
+		{name: "stakes too high", filter: &ppb.TableFilter{MaxBigBlind: 10}, want: false},
+		{name: "not full", filter: &ppb.TableFilter{HideFull: true, HideEmpty: true}, want: true},
+		{name: "tournaments only", filter: &ppb.TableFilter{TournamentOnly: true}, want: false},
+		{name: "cash only", filter: &ppb.TableFilter{CashOnly: true}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesFilter(s, tt.filter); got != tt.want {
+				t.Errorf("MatchesFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"fmt"
 
 	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
 	"github.com/DanTulovsky/pepper-poker-v2/server/player"
 
 	"github.com/dustin/go-humanize"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
 )
 
 var ()
@@ -23,9 +27,92 @@ func (t *Table) bet(p *player.Player, bet int64, a actions.TableAction) error {
 		return fmt.Errorf("bet cannot be < 0 (sent: %v)", bet)
 	}
 
+	limits := t.betLimits(p)
+	if bet > limits.Call {
+		structure := t.bettingStructure.Type()
+		switch {
+		case !limits.CanRaise:
+			return fmt.Errorf("%v: no more raises allowed this round, can only call $%v", structure, humanize.Comma(limits.Call))
+		case bet > limits.MaxRaise:
+			return fmt.Errorf("%v: maximum bet is $%v", structure, humanize.Comma(limits.MaxRaise))
+		case bet < limits.MinRaise && bet != p.Money().Stack():
+			return fmt.Errorf("%v: minimum raise is $%v", structure, humanize.Comma(limits.MinRaise))
+		}
+	}
+
+	currentBet := t.minBetThisRound
+	t.spendTimeBank(p)
+	t.commitBet(p, bet, a)
+	t.recordBet(p, bet, currentBet)
+	return nil
+}
+
+// betContext returns the table state used to compute the legal bets of the player
+func (t *Table) betContext(p *player.Player) BetContext {
+	return BetContext{
+		Street:       t.State.Name(),
+		BigBlind:     t.bigBlind,
+		Pot:          t.pot.GetTotal(),
+		CurrentBet:   t.minBetThisRound,
+		LastRaise:    t.lastRaiseThisRound,
+		Raises:       t.raisesThisRound,
+		BetThisRound: p.Money().BetThisRound(),
+		Stack:        p.Money().Stack(),
+	}
+}
+
+// betLimits returns the legal bet sizes for the player's next action
+func (t *Table) betLimits(p *player.Player) BetLimits {
+	return t.bettingStructure.Limits(t.betContext(p))
+}
+
+// allowedActions returns the actions the player may take right now
+// It mirrors the checks done by the state's Bet/Check/Call/Fold/AllIn and by t.bet
+func (t *Table) allowedActions(p *player.Player) []ppb.PlayerAction {
+	if t.State.WaitingTurnPlayer() != p || !p.ActionRequired() {
+		return nil
+	}
+
+	limits := t.betLimits(p)
+	stack := p.Money().Stack()
+
+	allowed := []ppb.PlayerAction{ppb.PlayerAction_PlayerActionFold}
+
+	if limits.Call == 0 {
+		allowed = append(allowed, ppb.PlayerAction_PlayerActionCheck)
+	} else {
+		allowed = append(allowed, ppb.PlayerAction_PlayerActionCall)
+	}
+	if limits.CanRaise {
+		allowed = append(allowed, ppb.PlayerAction_PlayerActionBet)
+	}
+	if stack > 0 && (stack == limits.Call || (limits.CanRaise && stack <= limits.MaxRaise)) {
+		allowed = append(allowed, ppb.PlayerAction_PlayerActionAllIn)
+	}
+
+	return allowed
+}
+
+// postBlind puts in a forced blind bet, capped at the player's stack
+// Blinds are not subject to the betting limits of the table
+func (t *Table) postBlind(p *player.Player, blind int64, a actions.TableAction, e handhistory.EventType) error {
+	if blind > p.Money().Stack() {
+		blind = p.Money().Stack()
+	}
+	if blind < 0 {
+		return fmt.Errorf("blind cannot be < 0 (sent: %v)", blind)
+	}
+
+	t.commitBet(p, blind, a)
+	t.recordAction(p, e, blind)
+	return nil
+}
+
+// commitBet moves an already validated bet from the player's stack into the pot
+func (t *Table) commitBet(p *player.Player, bet int64, a actions.TableAction) {
 	m := p.Money()
 
-	m.SetStack(m.Stack() - bet)
+	t.setStack(p, m.Stack()-bet, ledger.Pot(t.ID), a.String())
 	m.SetBetThisRound(m.BetThisRound() + bet)
 	p.GoAllIn(m.Stack() == 0)
 	p.SetActionRequired(false)
@@ -34,6 +121,11 @@ func (t *Table) bet(p *player.Player, bet int64, a actions.TableAction) error {
 		t.pot.Add(p.ID, bet, p.AllIn())
 
 		if p.Money().BetThisRound() > t.minBetThisRound {
+			// an all in for less than a full raise does not change the minimum raise
+			if raise := p.Money().BetThisRound() - t.minBetThisRound; raise >= t.lastRaiseThisRound {
+				t.lastRaiseThisRound = raise
+			}
+			t.raisesThisRound++
 			t.minBetThisRound = p.Money().BetThisRound()
 
 			// reset any players that have put in less than this so they get to go again
@@ -42,6 +134,7 @@ func (t *Table) bet(p *player.Player, bet int64, a actions.TableAction) error {
 					p.SetActionRequired(true)
 				}
 			}
+			t.cancelPreActions()
 		}
 	}
 
@@ -49,11 +142,11 @@ func (t *Table) bet(p *player.Player, bet int64, a actions.TableAction) error {
 	p.SetLastAction(a, bet) // covers bet, call, allin, check
 	p.SetActionRequired(false)
 	p.CurrentTurn++
-	return nil
 }
 
+// call calls the current bet, or goes all in if the stack is smaller than the bet
 func (t *Table) call(p *player.Player) error {
-	bet := t.minBetThisRound - p.Money().BetThisRound()
+	bet := t.betLimits(p).Call
 	if bet == 0 {
 		return fmt.Errorf("no bet is needed to call, should check instead")
 	}
@@ -61,20 +154,6 @@ func (t *Table) call(p *player.Player) error {
 	return t.bet(p, bet, actions.ActionCall)
 }
 
-func (t *Table) buyin(p *player.Player) error {
-	if p.Money().Bank() < t.buyinAmount {
-		return fmt.Errorf("table buyin is [$%v], player has: $%v", humanize.Comma(t.buyinAmount), humanize.Comma(p.Money().Stack()))
-	}
-
-	stack := p.Money().Stack() + t.buyinAmount
-	bank := p.Money().Bank() - t.buyinAmount
-	p.Money().SetStack(stack)
-	p.Money().SetBank(bank)
-
-	p.Stats.ActionInc(actions.ActionBuyIn)
-	return nil
-}
-
 func (t *Table) allin(p *player.Player) error {
 	return t.bet(p, p.Money().Stack(), actions.ActionAllIn)
 }
@@ -84,8 +163,10 @@ func (t *Table) check(p *player.Player) error {
 }
 
 func (t *Table) fold(p *player.Player) error {
+	t.spendTimeBank(p)
 	p.Fold()
 	p.SetLastAction(actions.ActionFold, 0)
+	t.recordAction(p, handhistory.EventFold, 0)
 
 	p.SetActionRequired(false)
 	p.CurrentTurn++
@@ -0,0 +1,74 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// setPreAction queues an action for the player's next turn this betting round, PreActionNone cancels it
+func (t *Table) setPreAction(p *player.Player, a ppb.PreAction) error {
+	if _, ok := ppb.PreAction_name[int32(a)]; !ok {
+		return fmt.Errorf("invalid pre-action: %v", a)
+	}
+	if a == ppb.PreAction_PreActionNone {
+		p.ClearPreAction()
+		return nil
+	}
+
+	if !p.InList(t.currentHandPlayers) || p.Folded() || p.AllIn() {
+		return fmt.Errorf("player [%v] cannot act in the current hand", p.Name)
+	}
+	if t.State.WaitingTurnPlayer() == p {
+		return fmt.Errorf("it's your turn, take your turn instead")
+	}
+
+	call := t.betLimits(p).Call
+	switch {
+	case a == ppb.PreAction_PreActionCheck && call > 0:
+		return fmt.Errorf("cannot check, there is a bet of $%v to call", call)
+	case a == ppb.PreAction_PreActionCall && call == 0:
+		return fmt.Errorf("no bet to call, should check instead")
+	}
+
+	p.SetPreAction(a, call)
+	return nil
+}
+
+// cancelPreActions cancels the pre-actions made invalid by a change of the bet
+func (t *Table) cancelPreActions() {
+	for _, p := range t.currentHandPlayers {
+		a, call := p.PreAction()
+		if (a == ppb.PreAction_PreActionCheck || a == ppb.PreAction_PreActionCall) && t.betLimits(p).Call != call {
+			t.l.Infof("[%v] the bet changed, cancelling %v", p.Name, a)
+			p.ClearPreAction()
+		}
+	}
+}
+
+// applyPreAction takes the action the player queued, once it is their turn
+func (t *Table) applyPreAction(p *player.Player) {
+	a, _ := p.PreAction()
+	if a == ppb.PreAction_PreActionNone || !p.ActionRequired() {
+		return
+	}
+	p.ClearPreAction()
+
+	t.l.Infof("[%v] taking pre-action %v", p.Name, a)
+	call := t.betLimits(p).Call
+
+	var err error
+	switch {
+	case a == ppb.PreAction_PreActionFold, a == ppb.PreAction_PreActionCheckFold && call > 0:
+		err = t.fold(p)
+	case a == ppb.PreAction_PreActionCall, a == ppb.PreAction_PreActionCallAny && call > 0:
+		err = t.call(p)
+	default:
+		err = t.check(p)
+	}
+	if err != nil {
+		t.l.Errorf("[%v] pre-action %v failed: %v", p.Name, a, err)
+	}
+}
@@ -0,0 +1,56 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestPreActions(t *testing.T) {
+	tests := []struct {
+		name string
+		// the bet when the pre-action is queued, and after someone bets
+		bet, newBet int64
+		a           ppb.PreAction
+		wantErr     bool
+		wantKept    bool
+	}{
+		{name: "check, no bet", a: ppb.PreAction_PreActionCheck, wantKept: true},
+		{name: "check, cancelled by a bet", newBet: 20, a: ppb.PreAction_PreActionCheck},
+		{name: "check facing a bet", bet: 20, a: ppb.PreAction_PreActionCheck, wantErr: true},
+		{name: "call, same bet", bet: 20, newBet: 20, a: ppb.PreAction_PreActionCall, wantKept: true},
+		{name: "call, cancelled by a raise", bet: 20, newBet: 60, a: ppb.PreAction_PreActionCall},
+		{name: "call with nothing to call", a: ppb.PreAction_PreActionCall, wantErr: true},
+		{name: "call any, kept after a raise", bet: 20, newBet: 60, a: ppb.PreAction_PreActionCallAny, wantKept: true},
+		{name: "check/fold, kept after a bet", newBet: 20, a: ppb.PreAction_PreActionCheckFold, wantKept: true},
+		{name: "fold, kept after a bet", newBet: 20, a: ppb.PreAction_PreActionFold, wantKept: true},
+		{name: "invalid", a: ppb.PreAction(100), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := New(make(chan ActionRequest), DefaultTableConfig())
+			p := player.New(users.User{Username: "a"})
+			p.Money().SetStack(1000)
+			tbl.AddCurrentHandPlayer(p)
+
+			tbl.minBetThisRound = tt.bet
+			err := tbl.setPreAction(p, tt.a)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setPreAction(%v) = %v, wantErr %v", tt.a, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			tbl.minBetThisRound = tt.newBet
+			tbl.cancelPreActions()
+
+			if got, _ := p.PreAction(); (got == tt.a) != tt.wantKept {
+				t.Errorf("PreAction() = %v, want kept: %v", got, tt.wantKept)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package table
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+)
+
+const (
+	// replayTicks stops a replay that no longer moves, one tick is a second of table time
+	replayTicks = 1000
+	// number of transactions kept by the ledger of a replayed hand
+	replayLedgerSize = 1000
+)
+
+// replayHand is the deal of a recorded hand, used in place of the table's own
+type replayHand struct {
+	seed                         int64
+	button, smallBlind, bigBlind int
+}
+
+// Replay plays the recorded hand again at a new table: it deals from the recorded seed, posts the same blinds and
+// takes the recorded actions as each player's turn comes up, through the same states as the original hand.
+// c is the config of the table the hand was played at; the stakes, seats and stacks come from the hand.
+// It returns the replayed hand, and an error at the first event that does not match the recorded hand.
+func Replay(h *handhistory.Hand, c TableConfig) (*handhistory.Hand, error) {
+	switch {
+	case h.Tournament:
+		return nil, fmt.Errorf("hand %d: tournament hands cannot be replayed", h.Number)
+	case len(h.EventsOf(handhistory.EventDeadBlind)) > 0:
+		return nil, fmt.Errorf("hand %d: dead blinds depend on earlier hands, the hand cannot be replayed", h.Number)
+	}
+
+	clk := clock.NewFake(h.Start)
+	c.Name = h.TableName
+	c.Clock = clk
+	// the replay moves chips of its own, they never show up in the ledger of the real tables
+	c.Ledger = ledger.New(replayLedgerSize)
+	c.Variant = h.Variant
+	c.BettingStructure = h.BettingStructure
+	c.SmallBlind, c.BigBlind, c.Ante, c.BigBlindAnte = h.SmallBlind, h.BigBlind, h.Ante, h.BigBlindAnte
+	c.MinPlayers, c.MaxPlayers = 2, h.MaxPlayers
+	c.Straddle = len(h.EventsOf(handhistory.EventStraddle)) > 0
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("hand %d: %v", h.Number, err)
+	}
+
+	t := New(make(chan ActionRequest), c)
+	t.replay = &replayHand{
+		seed:       h.Seed,
+		button:     h.Button,
+		smallBlind: -1,
+		bigBlind:   -1,
+	}
+	// the table shuffled a deck of its own when it was created
+	t.deck = t.shuffledDeck()
+
+	// the players are new, the replayed hand is reported with the recorded player IDs
+	players := make(map[id.PlayerID]*player.Player)
+	recorded := make(map[id.PlayerID]id.PlayerID)
+	for _, s := range h.Seats {
+		p, err := t.replaySeat(s)
+		if err != nil {
+			return nil, fmt.Errorf("hand %d: %v", h.Number, err)
+		}
+		players[s.PlayerID] = p
+		recorded[p.ID] = s.PlayerID
+	}
+
+	// every player named in the hand is seated, a hand history edited by hand may not be
+	for _, e := range h.Events {
+		if e.PlayerID == "" {
+			continue
+		}
+		p, ok := players[e.PlayerID]
+		if !ok {
+			return nil, fmt.Errorf("hand %d: %v by [%v], who is not seated", h.Number, e.Type, e.PlayerID)
+		}
+
+		switch e.Type {
+		case handhistory.EventSmallBlind:
+			t.replay.smallBlind = p.TablePosition
+		case handhistory.EventBigBlind:
+			t.replay.bigBlind = p.TablePosition
+		case handhistory.EventStraddle:
+			t.straddlers[p.ID] = true
+		}
+	}
+	if t.replay.bigBlind < 0 {
+		return nil, fmt.Errorf("hand %d: no big blind was posted", h.Number)
+	}
+
+	var turns []handhistory.Event
+	for _, e := range h.Events {
+		switch e.Type {
+		case handhistory.EventFold, handhistory.EventCheck, handhistory.EventCall, handhistory.EventBet, handhistory.EventRaise:
+			turns = append(turns, e)
+		}
+	}
+
+	for tick := 0; tick < replayTicks; tick++ {
+		clk.Advance(time.Second)
+		if tok := t.currentAckToken; tok != nil {
+			for _, p := range t.CurrentHandPlayers() {
+				tok.Ack(p)
+			}
+		}
+
+		state := t.State
+		if err := t.Tick(); err != nil {
+			return nil, fmt.Errorf("hand %d: %v", h.Number, err)
+		}
+
+		if hands := t.handHistory.Hands(); len(hands) > 0 {
+			replayed := hands[0]
+			replayed.Number = h.Number
+			for i, s := range replayed.Seats {
+				replayed.Seats[i].PlayerID = recorded[s.PlayerID]
+			}
+			for i, e := range replayed.Events {
+				replayed.Events[i].PlayerID = recorded[e.PlayerID]
+			}
+			return replayed, compareHands(h, replayed)
+		}
+
+		// a new state is only set up on the next tick
+		if t.State != state {
+			continue
+		}
+		p := t.State.WaitingTurnPlayer()
+		if p == nil || !p.ActionRequired() {
+			continue
+		}
+		if len(turns) == 0 {
+			return nil, fmt.Errorf("hand %d: [%v] is to act in %v, the recorded hand has no more actions", h.Number, p.Name, t.State.Name())
+		}
+
+		e := turns[0]
+		turns = turns[1:]
+		if recorded[p.ID] != e.PlayerID {
+			name := e.PlayerID.String()
+			if rp, ok := players[e.PlayerID]; ok {
+				name = rp.Name
+			}
+			return nil, fmt.Errorf("hand %d: [%v] is to act in %v, the recorded hand has %v by [%v]", h.Number, p.Name, t.State.Name(), e.Type, name)
+		}
+
+		var a actions.TableAction
+		var opts interface{}
+		switch e.Type {
+		case handhistory.EventFold:
+			a = actions.ActionFold
+		case handhistory.EventCheck:
+			a = actions.ActionCheck
+		case handhistory.EventCall:
+			a = actions.ActionCall
+		case handhistory.EventBet, handhistory.EventRaise:
+			a, opts = actions.ActionBet, e.Amount
+		}
+		if res := t.Do(a, p, opts); res.Err != nil {
+			return nil, fmt.Errorf("hand %d: [%v] %v in %v: %v", h.Number, p.Name, e.Type, t.State.Name(), res.Err)
+		}
+	}
+
+	return nil, fmt.Errorf("hand %d: the replay did not finish in %d ticks (state: %v)", h.Number, replayTicks, t.State.Name())
+}
+
+// replaySeat seats a new player with the recorded stack
+func (t *Table) replaySeat(s handhistory.Seat) (*player.Player, error) {
+	p := player.New(users.User{Username: s.Name, Name: s.Name, Bank: s.Stack})
+	if err := t.ledger.Transfer(t.ID, ledger.External(), ledger.Bank(p.ID), s.Stack, "replay"); err != nil {
+		return nil, err
+	}
+
+	pos, err := t.openSeat(s.Position)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.moveToStack(p, s.Stack, "replay"); err != nil {
+		return nil, err
+	}
+
+	t.positions[pos] = p
+	p.TablePosition = pos
+	// never read, the players only need to look connected
+	p.CommChannel = make(chan actions.GameData, 1)
+	return p, nil
+}
+
+// compareHands returns an error describing the first difference between the recorded and the replayed hand
+func compareHands(recorded, replayed *handhistory.Hand) error {
+	if recorded.Button != replayed.Button {
+		return fmt.Errorf("hand %d: the button is at %d, recorded at %d", recorded.Number, replayed.Button, recorded.Button)
+	}
+
+	for i, e := range recorded.Events {
+		if i >= len(replayed.Events) {
+			return fmt.Errorf("hand %d: the replay ended after %d events, recorded %v is missing", recorded.Number, i, e.Type)
+		}
+		if !sameEvent(e, replayed.Events[i]) {
+			return fmt.Errorf("hand %d: event %d is %+v, recorded %+v", recorded.Number, i, replayed.Events[i], e)
+		}
+	}
+	if len(replayed.Events) > len(recorded.Events) {
+		return fmt.Errorf("hand %d: the replay has %d events, recorded %d", recorded.Number, len(replayed.Events), len(recorded.Events))
+	}
+	return nil
+}
+
+// sameEvent returns true if the events are the same
+func sameEvent(a, b handhistory.Event) bool {
+	if a.Type != b.Type || a.Street != b.Street || a.PlayerID != b.PlayerID ||
+		a.Amount != b.Amount || a.RaiseTo != b.RaiseTo || a.AllIn != b.AllIn ||
+		a.Combo != b.Combo || a.Pot != b.Pot || len(a.Cards) != len(b.Cards) {
+		return false
+	}
+	for i := range a.Cards {
+		if !a.Cards[i].IsSame(b.Cards[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package table
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+// playHands plays n hands between three players at a new table with its own ledger
+// The raiser makes the smallest raise whenever it can, and straddles if the table allows it; the others check or call.
+func playHands(t *testing.T, c TableConfig, n int) []*handhistory.Hand {
+	clk := clock.NewFake(time.Unix(0, 0))
+	c.Clock = clk
+	c.Seed = 7
+	c.Ledger = ledger.New(ledgerSize)
+	tbl := New(make(chan ActionRequest), c)
+
+	var raiser *player.Player
+	for i, name := range []string{"checker", "raiser", "caller"} {
+		p, err := tbl.replaySeat(handhistory.Seat{Position: i, Name: name, Stack: c.MaxBuyin * c.BigBlind})
+		if err != nil {
+			t.Fatalf("replaySeat() = %v", err)
+		}
+		if name == "raiser" {
+			raiser = p
+			if c.Straddle {
+				if err := tbl.setStraddle(p, true); err != nil {
+					t.Fatalf("setStraddle() = %v", err)
+				}
+			}
+		}
+	}
+
+	for tick := 0; len(tbl.HandHistory().Hands()) < n; tick++ {
+		if tick > replayTicks*n {
+			t.Fatalf("played %d of %d hands in %d ticks (state: %v)", len(tbl.HandHistory().Hands()), n, tick, tbl.State.Name())
+		}
+
+		clk.Advance(time.Second)
+		if tok := tbl.currentAckToken; tok != nil {
+			for _, p := range tbl.CurrentHandPlayers() {
+				tok.Ack(p)
+			}
+		}
+
+		state := tbl.State
+		if err := tbl.Tick(); err != nil {
+			t.Fatalf("Tick() = %v", err)
+		}
+		// a new state is only set up on the next tick
+		if tbl.State != state {
+			continue
+		}
+		p := tbl.State.WaitingTurnPlayer()
+		if p == nil || !p.ActionRequired() {
+			continue
+		}
+
+		a, opts := actions.ActionCall, interface{}(nil)
+		limits := tbl.betLimits(p)
+		switch {
+		case p == raiser && limits.CanRaise && limits.MinRaise <= limits.MaxRaise:
+			a, opts = actions.ActionBet, limits.MinRaise
+		case limits.Call == 0:
+			a = actions.ActionCheck
+		}
+		if res := tbl.Do(a, p, opts); res.Err != nil {
+			t.Fatalf("[%v] %v in %v: %v", p.Name, a, tbl.State.Name(), res.Err)
+		}
+	}
+
+	return tbl.HandHistory().Hands()
+}
+
+func TestReplay(t *testing.T) {
+	dir := t.TempDir()
+	defer func(d string) { *handHistoryDir = d }(*handHistoryDir)
+	*handHistoryDir = dir
+
+	c := DefaultTableConfig()
+	c.Name = "replayed"
+	c.Straddle = true
+	played := playHands(t, c, 20)
+
+	// hands are replayed from the JSON hand history, like a hand reported from a running server
+	files, err := filepath.Glob(filepath.Join(dir, "replayed-*.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("hand history files: %v (%v), want one", files, err)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatalf("Open() = %v", err)
+	}
+	defer f.Close()
+	hands, err := handhistory.ReadJSON(f)
+	if err != nil {
+		t.Fatalf("ReadJSON() = %v", err)
+	}
+	if len(hands) != len(played) {
+		t.Fatalf("hand history has %d hands, played %d", len(hands), len(played))
+	}
+
+	shared := len(Ledger().Audit(ledger.Query{}))
+	for i, h := range hands {
+		if h.Number != played[i].Number || h.Seed != played[i].Seed {
+			t.Errorf("hand %d with seed %d in the hand history, played hand %d with seed %d", h.Number, h.Seed, played[i].Number, played[i].Seed)
+		}
+		if _, err := Replay(h, DefaultTableConfig()); err != nil {
+			t.Errorf("Replay() = %v", err)
+		}
+	}
+	if got := len(Ledger().Audit(ledger.Query{})); got != shared {
+		t.Errorf("replays recorded %d transactions in the shared ledger, want none", got-shared)
+	}
+
+	// a hand that did not play out as recorded
+	h := *hands[0]
+	h.Events = append([]handhistory.Event(nil), h.Events...)
+	for i, e := range h.Events {
+		if e.Type == handhistory.EventCollect {
+			h.Events[i].Amount++
+		}
+	}
+	if _, err := Replay(&h, DefaultTableConfig()); err == nil {
+		t.Errorf("Replay() of an altered hand = nil, want error")
+	}
+
+	// a hand naming a player who is not seated
+	for _, typ := range []handhistory.EventType{handhistory.EventBigBlind, handhistory.EventCall} {
+		h := *hands[0]
+		h.Events = append([]handhistory.Event(nil), h.Events...)
+		for i, e := range h.Events {
+			if e.Type == typ {
+				h.Events[i].PlayerID = "unknown"
+			}
+		}
+		if _, err := Replay(&h, DefaultTableConfig()); err == nil {
+			t.Errorf("Replay() with a %v by a player not seated = nil, want error", typ)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/handhistory"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+// cashOut returns the player's stack to the bank
+func (t *Table) cashOut(p *player.Player) {
+	switch {
+	case t.tournament != nil:
+		// tournament chips are not bank money
+		t.tournamentPlayerLeft(p)
+	default:
+		// return Stack() to Bank()
+		stack := p.Money().Stack()
+		bank := p.Money().Bank()
+		if err := t.setBank(p, bank+stack, ledger.Stack(p.ID), "cash out"); err != nil {
+			t.l.Errorf("[%v] %v", p.Name, err)
+			return
+		}
+		// recorded in the ledger with the bank
+		p.Money().SetStack(0)
+
+		t.l.Infof("[%v] returning [%v] stack to bank (now = %v)", p.Name, stack, p.Money().Bank())
+	}
+}
+
+// leaveTable stands the player up once the current hand is done, right away if the player is not in it
+// Unlike a disconnect, the player keeps the Play stream and can join another table.
+func (t *Table) leaveTable(p *player.Player) error {
+	if !t.playerAtTable(p) {
+		return fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+
+	if p.InList(t.currentHandPlayers) {
+		t.l.Infof("[%v] leaves table [%v] after the current hand", p.Name, t.Name)
+		p.LeaveAfterHand()
+		return nil
+	}
+
+	t.standUp(p)
+	return nil
+}
+
+// standUp cashes out the player and frees the seat, without closing the player's comm channel
+func (t *Table) standUp(p *player.Player) {
+	t.l.Infof("[%v] standing up from table [%v]...", p.Name, t.Name)
+
+	t.cashOut(p)
+	t.unseat(p)
+	p.Init()
+}
+
+// sitOut keeps the player's seat without dealing the player in, for hands hands or until the player sits back in
+// A player in the current hand plays it out.
+func (t *Table) sitOut(p *player.Player, hands int) error {
+	if t.tournament != nil {
+		return fmt.Errorf("cannot sit out of a tournament")
+	}
+	if hands < 0 {
+		return fmt.Errorf("hands to sit out cannot be < 0 (have: %v)", hands)
+	}
+	if !t.playerAtTable(p) {
+		return fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+
+	t.l.Infof("[%v] sitting out (hands: %v)", p.Name, hands)
+	p.SitOut(hands)
+	return nil
+}
+
+// sitIn deals the player back in from the next hand, this also cancels leaving the table
+func (t *Table) sitIn(p *player.Player) error {
+	if !p.SittingOut() && !p.Leaving() {
+		return fmt.Errorf("[%v] is not sitting out", p.Name)
+	}
+
+	t.l.Infof("[%v] sitting back in (missed blinds: %v)", p.Name, p.MissedBlinds())
+	p.SitIn()
+	return nil
+}
+
+// applySeatChanges counts the hand for players sitting out, stands up the players leaving and moves players
+// that asked to change seats, called when a hand is done
+func (t *Table) applySeatChanges() {
+	for _, p := range t.PresentPlayers() {
+		switch {
+		case p.SittingOut() && !p.InList(t.currentHandPlayers):
+			p.SatOutHand()
+		case p.Leaving():
+			t.standUp(p)
+		}
+	}
+
+	for _, p := range t.PresentPlayers() {
+		seat, ok := t.seatChanges[p.ID]
+		if !ok {
+			continue
+		}
+		delete(t.seatChanges, p.ID)
+
+		if err := t.moveSeat(p, seat); err != nil {
+			t.l.Errorf("[%v] cannot change seats: %v", p.Name, err)
+		}
+	}
+}
+
+// openSeat returns the seat if it is open, or a random open seat if seat is < 0
+func (t *Table) openSeat(seat int) (int, error) {
+	if seat < 0 {
+		if pos := t.randomAvailablePosition(); pos >= 0 {
+			return pos, nil
+		}
+		return -1, fmt.Errorf("no available positions at table")
+	}
+
+	if seat >= t.maxPlayers {
+		return -1, fmt.Errorf("table has %v seats, there is no seat %v", t.maxPlayers, seat+1)
+	}
+	if t.positions[seat] != nil {
+		return -1, fmt.Errorf("seat %v is taken", seat+1)
+	}
+	return seat, nil
+}
+
+// changeSeat moves the player to an open seat, once the current hand is done if the player is in it
+func (t *Table) changeSeat(p *player.Player, seat int) error {
+	if t.tournament != nil {
+		return fmt.Errorf("tournament seats are assigned")
+	}
+	if !t.playerAtTable(p) {
+		return fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+	if _, err := t.openSeat(seat); err != nil {
+		return err
+	}
+
+	if p.InList(t.currentHandPlayers) {
+		t.l.Infof("[%v] moves to seat %v after the current hand", p.Name, seat+1)
+		t.seatChanges[p.ID] = seat
+		return nil
+	}
+
+	return t.moveSeat(p, seat)
+}
+
+// moveSeat moves the player to an open seat
+// A player moving to a seat the big blind reaches later skips the blinds in between, and owes a missed big blind.
+func (t *Table) moveSeat(p *player.Player, seat int) error {
+	if _, err := t.openSeat(seat); err != nil {
+		return err
+	}
+
+	if t.blindDistance(seat) > t.blindDistance(p.TablePosition) {
+		if blind := min64(t.bigBlind, t.smallBlind+t.bigBlind-p.MissedBlinds()); blind > 0 {
+			t.l.Infof("[%v] moves away from the big blind, owes a missed blind ($%v)", p.Name, blind)
+			p.MissBlind(blind)
+		}
+	}
+
+	t.l.Infof("[%v] moves from seat %v to seat %v", p.Name, p.TablePosition+1, seat+1)
+	t.positions[p.TablePosition] = nil
+	t.positions[seat] = p
+	p.TablePosition = seat
+	return nil
+}
+
+// blindDistance returns how many seats the big blind moves before it reaches the seat, 0 before the first hand
+func (t *Table) blindDistance(seat int) int {
+	if t.lastBigBlindPosition < 0 {
+		return 0
+	}
+
+	d := (seat - t.lastBigBlindPosition + t.maxPlayers) % t.maxPlayers
+	if d == 0 {
+		// the player in the big blind last hand is the last to get it again
+		d = t.maxPlayers
+	}
+	return d
+}
+
+// chargeMissedBlinds charges the blinds that passed players sitting out, or disconnected, since the last hand
+// A player owes at most a small and a big blind, however long they sat out.
+func (t *Table) chargeMissedBlinds() {
+	if t.lastBigBlindPosition >= 0 {
+		owed := t.smallBlind + t.bigBlind
+
+		for _, p := range t.PresentPlayers() {
+			if !p.SittingOut() && !p.Disconnected() {
+				continue
+			}
+
+			var blind int64
+			switch {
+			case seatPassed(p.TablePosition, t.lastBigBlindPosition, t.bigBlindPosition, t.maxPlayers):
+				blind = t.bigBlind
+			case seatPassed(p.TablePosition, t.lastSmallBlindPosition, t.smallBlindPosition, t.maxPlayers):
+				blind = t.smallBlind
+			}
+
+			if blind = min64(blind, owed-p.MissedBlinds()); blind > 0 {
+				t.l.Infof("[%v] missed a blind ($%v) while sitting out", p.Name, blind)
+				p.MissBlind(blind)
+			}
+		}
+	}
+
+	t.lastSmallBlindPosition, t.lastBigBlindPosition = t.smallBlindPosition, t.bigBlindPosition
+}
+
+// seatPassed returns true if the seat is after from and before to, going around the table
+func seatPassed(seat, from, to, seats int) bool {
+	if from < 0 || to < 0 {
+		return false
+	}
+	d := (seat - from + seats) % seats
+	return d > 0 && d < (to-from+seats)%seats
+}
+
+// postMissedBlinds takes the missed blinds of players back in the hand, as dead money
+func (t *Table) postMissedBlinds() {
+	for _, p := range t.CurrentHandPlayers() {
+		blind := min64(p.MissedBlinds(), p.Money().Stack())
+		p.ClearMissedBlinds()
+		if blind == 0 {
+			continue
+		}
+
+		t.l.Infof("[%v] posting missed blinds ($%v)", p.Name, blind)
+		t.setStack(p, p.Money().Stack()-blind, ledger.Pot(t.ID), "missed blinds")
+		p.GoAllIn(p.Money().Stack() == 0)
+		t.pot.Add(p.ID, blind, p.AllIn())
+		p.SetLastAction(actions.ActionPostDeadBlind, blind)
+		t.recordAction(p, handhistory.EventDeadBlind, blind)
+	}
+}
+
+// playerStreamClosed holds the seat of a player whose Play stream ended, for them to reconnect
+// Returns true if the seat is held. Without a grace period the player is removed right away, and streams already
+// replaced by a reconnect are ignored.
+func (t *Table) playerStreamClosed(p *player.Player, cc chan actions.GameData) (bool, error) {
+	if !t.playerAtTable(p) {
+		return false, fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+	if cc != nil && cc != p.CommChannel {
+		return true, nil
+	}
+	if t.config.DisconnectGrace <= 0 {
+		return false, t.PlayerDisconnected(p)
+	}
+
+	if p.CommChannel != nil {
+		close(p.CommChannel)
+		p.CommChannel = nil
+	}
+	if t.currentAckToken != nil {
+		t.currentAckToken.Ack(p)
+	}
+
+	t.l.Infof("[%v] disconnected, holding the seat for %v", p.Name, t.config.DisconnectGrace)
+	p.Disconnect(t.clock.Now())
+	return true, nil
+}
+
+// expireHeldSeats removes the disconnected players that did not reconnect within the grace period
+func (t *Table) expireHeldSeats() {
+	for _, p := range t.PresentPlayers() {
+		if !p.Disconnected() || t.clock.Since(p.DisconnectedAt()) < t.config.DisconnectGrace {
+			continue
+		}
+
+		t.l.Infof("[%v] did not reconnect in %v", p.Name, t.config.DisconnectGrace)
+		if err := t.PlayerDisconnected(p); err != nil {
+			t.l.Error(err)
+		}
+	}
+}
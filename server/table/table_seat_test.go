@@ -0,0 +1,154 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+)
+
+func TestSeatPassed(t *testing.T) {
+	tests := []struct {
+		name           string
+		seat, from, to int
+		want           bool
+	}{
+		{name: "between", seat: 2, from: 1, to: 3, want: true},
+		{name: "at from", seat: 1, from: 1, to: 3, want: false},
+		{name: "at to", seat: 3, from: 1, to: 3, want: false},
+		{name: "after to", seat: 4, from: 1, to: 3, want: false},
+		{name: "around the table", seat: 0, from: 5, to: 1, want: true},
+		{name: "no previous hand", seat: 2, from: -1, to: 3, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seatPassed(tt.seat, tt.from, tt.to, 7); got != tt.want {
+				t.Errorf("seatPassed(%v, %v, %v) = %v, want %v", tt.seat, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChargeMissedBlinds(t *testing.T) {
+	tbl := New(make(chan ActionRequest), DefaultTableConfig())
+	p := player.New(users.User{Username: "a", Bank: 5000})
+	tbl.positions[2] = p
+	p.TablePosition = 2
+	p.SitOut(0)
+
+	if got := tbl.AvailablePlayers(); len(got) != 0 {
+		t.Errorf("AvailablePlayers() = %v, want none while sitting out", got)
+	}
+
+	// the big blind moves past the player twice, the player owes one small and one big blind
+	for _, blinds := range [][2]int{{0, 1}, {1, 3}, {3, 4}, {0, 3}} {
+		tbl.smallBlindPosition, tbl.bigBlindPosition = blinds[0], blinds[1]
+		tbl.chargeMissedBlinds()
+	}
+	if got, want := p.MissedBlinds(), tbl.smallBlind+tbl.bigBlind; got != want {
+		t.Errorf("MissedBlinds() = %v, want %v", got, want)
+	}
+
+	if err := tbl.sitIn(p); err != nil {
+		t.Fatalf("sitIn() = %v", err)
+	}
+	if err := tbl.sitIn(p); err == nil {
+		t.Errorf("sitIn() of a player not sitting out = nil, want error")
+	}
+}
+
+func TestChangeSeat(t *testing.T) {
+	tbl := New(make(chan ActionRequest), DefaultTableConfig())
+
+	players := map[int]*player.Player{}
+	for _, seat := range []int{0, 2, 3, 5} {
+		p := player.New(users.User{Username: fmt.Sprintf("p%d", seat), Bank: 5000})
+		tbl.positions[seat] = p
+		p.TablePosition = seat
+		players[seat] = p
+		tbl.AddCurrentHandPlayer(p)
+	}
+
+	// button in seat 0, blinds in seats 2 and 3
+	tbl.buttonPosition, tbl.smallBlindPosition, tbl.bigBlindPosition = 0, 2, 3
+	tbl.lastSmallBlindPosition, tbl.lastBigBlindPosition = 2, 3
+
+	// the player in seat 5 is next to pay the big blind, moving in behind the button skips it
+	x := players[5]
+	tbl.ClearCurrentHandPlayers()
+	if err := tbl.changeSeat(x, 1); err != nil {
+		t.Fatalf("changeSeat() = %v", err)
+	}
+	if x.TablePosition != 1 {
+		t.Errorf("TablePosition = %v, want 1", x.TablePosition)
+	}
+	if got := x.MissedBlinds(); got != tbl.bigBlind {
+		t.Errorf("MissedBlinds() = %v, want %v", got, tbl.bigBlind)
+	}
+	if err := tbl.changeSeat(players[0], 1); err == nil {
+		t.Errorf("changeSeat() to a taken seat = nil, want error")
+	}
+
+	for _, p := range tbl.PresentPlayers() {
+		tbl.AddCurrentHandPlayer(p)
+	}
+	tbl.setBlindPositions()
+
+	// nobody pays the small blind twice, the last big blind posts it
+	if tbl.smallBlindPosition != 3 || tbl.bigBlindPosition != 0 || tbl.buttonPosition != 2 {
+		t.Errorf("button, small blind, big blind = %v, %v, %v; want 2, 3, 0", tbl.buttonPosition, tbl.smallBlindPosition, tbl.bigBlindPosition)
+	}
+}
+
+func TestDisconnectGrace(t *testing.T) {
+	c := DefaultTableConfig()
+	c.DisconnectGrace = time.Minute
+	tbl := New(make(chan ActionRequest), c)
+
+	p := player.New(users.User{Username: "a", Bank: 5000})
+	tbl.positions[0] = p
+	p.TablePosition = 0
+	if err := tbl.buyin(p, 0); err != nil {
+		t.Fatalf("buyin() = %v", err)
+	}
+
+	first := make(chan actions.GameData)
+	if err := tbl.registerPlayerCC(p, first); err != nil {
+		t.Fatalf("registerPlayerCC() = %v", err)
+	}
+
+	held, err := tbl.playerStreamClosed(p, first)
+	if err != nil || !held {
+		t.Fatalf("playerStreamClosed() = %v, %v; want seat held", held, err)
+	}
+	if !p.Disconnected() || p.CommChannel != nil {
+		t.Errorf("Disconnected() = %v, CommChannel = %v; want disconnected without a channel", p.Disconnected(), p.CommChannel)
+	}
+	if len(tbl.AvailablePlayers()) != 0 {
+		t.Errorf("disconnected player is dealt into the next hand")
+	}
+
+	// reconnect, then the end of the old stream is reported late
+	second := make(chan actions.GameData)
+	if err := tbl.registerPlayerCC(p, second); err != nil {
+		t.Fatalf("registerPlayerCC() = %v", err)
+	}
+	if _, err := tbl.playerStreamClosed(p, first); err != nil || p.Disconnected() || p.CommChannel != second {
+		t.Errorf("stale stream disconnected the player (err: %v)", err)
+	}
+
+	// disconnect again, and never come back
+	tbl.playerStreamClosed(p, second)
+	p.Disconnect(time.Now().Add(-c.DisconnectGrace))
+	tbl.expireHeldSeats()
+
+	if tbl.playerAtTable(p) {
+		t.Errorf("player still at the table after the grace period")
+	}
+	if got := p.Money().Bank(); got != 5000 {
+		t.Errorf("bank = %v, want the stack cashed out to 5000", got)
+	}
+}
@@ -0,0 +1,51 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+)
+
+// countingState counts the ticks of the state it wraps
+type countingState struct {
+	state
+	ticks int
+}
+
+func (s *countingState) Tick() error {
+	s.ticks++
+	return nil
+}
+
+func TestStateAdvanceDelay(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	c := DefaultTableConfig()
+	c.Clock = clk
+	tbl := New(make(chan ActionRequest), c)
+	tbl.stateAdvanceDelay = time.Second * 2
+
+	// changing state does not block, the new state waits out the delay on the table clock
+	s := &countingState{state: tbl.finishedState}
+	if err := tbl.setState(s); err != nil {
+		t.Fatalf("setState() = %v", err)
+	}
+
+	for _, step := range []struct {
+		advance time.Duration
+		want    int
+	}{
+		{advance: 0, want: 0},
+		{advance: time.Second, want: 0},
+		{advance: time.Second, want: 1},
+		{advance: 0, want: 2},
+	} {
+		clk.Advance(step.advance)
+		if err := tbl.Tick(); err != nil {
+			t.Fatalf("Tick() = %v", err)
+		}
+		if s.ticks != step.want {
+			t.Errorf("state ticks after %v = %v, want %v", clk.Now().Sub(tbl.stateSince), s.ticks, step.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package table
+
+import (
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+)
+
+// timeBank is the extra time a player has to act once the turn timer runs out
+type timeBank struct {
+	left time.Duration
+	// hands played since the last refill
+	hands int
+}
+
+// timeBankOf returns the player's time bank, a player sitting down starts with a full one
+func (t *Table) timeBankOf(p *player.Player) *timeBank {
+	tb, ok := t.timeBanks[p.ID]
+	if !ok {
+		tb = &timeBank{left: t.config.TimeBank}
+		t.timeBanks[p.ID] = tb
+	}
+	return tb
+}
+
+// overtime returns how long the player has been acting past the turn timer
+func (t *Table) overtime(p *player.Player) time.Duration {
+	if left := t.TurnTimeLeft(p); left < 0 {
+		return -left
+	}
+	return 0
+}
+
+// TimeBankLeft returns the time bank the player has left, including the time used during the current turn
+func (t *Table) TimeBankLeft(p *player.Player) time.Duration {
+	if p == nil {
+		return 0
+	}
+
+	left := t.timeBankOf(p).left - t.overtime(p)
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// spendTimeBank takes the time used past the turn timer out of the player's time bank, once the player acted
+func (t *Table) spendTimeBank(p *player.Player) {
+	tb := t.timeBankOf(p)
+	if used := t.overtime(p); used > 0 {
+		t.l.Infof("[%v] used %v of the time bank", p.Name, used.Truncate(time.Second))
+		tb.left -= used
+		if tb.left < 0 {
+			tb.left = 0
+		}
+	}
+}
+
+// refillTimeBanks adds to the time bank of the players in the hand every few hands, up to the full time bank
+func (t *Table) refillTimeBanks() {
+	if t.config.TimeBankRefillHands == 0 {
+		return
+	}
+
+	for _, p := range t.CurrentHandPlayers() {
+		tb := t.timeBankOf(p)
+		if tb.hands++; tb.hands < t.config.TimeBankRefillHands {
+			continue
+		}
+
+		tb.hands = 0
+		tb.left += t.config.TimeBankRefill
+		if tb.left > t.config.TimeBank {
+			tb.left = t.config.TimeBank
+		}
+	}
+}
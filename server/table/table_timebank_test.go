@@ -0,0 +1,90 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+)
+
+func TestTimeBank(t *testing.T) {
+	c := DefaultTableConfig()
+	c.PlayerTimeout = time.Second * 30
+	c.TimeBank = time.Second * 60
+	c.TimeBankRefill = time.Second * 10
+	c.TimeBankRefillHands = 2
+	clk := clock.NewFake(time.Now())
+	c.Clock = clk
+
+	tbl := New(make(chan ActionRequest), c)
+	p := player.New(users.User{Username: "p"})
+	tbl.AddCurrentHandPlayer(p)
+
+	tests := []struct {
+		name string
+		// time taken to act, and the number of hands played after acting
+		took  time.Duration
+		hands int
+		want  time.Duration
+	}{
+		{name: "acts in time", took: time.Second * 20, want: time.Second * 60},
+		{name: "uses the time bank", took: time.Second * 55, want: time.Second * 35},
+		{name: "refill", took: time.Second * 10, hands: 2, want: time.Second * 45},
+		{name: "refill on every other hand", hands: 1, want: time.Second * 45},
+		{name: "refill caps at the full time bank", hands: 5, want: time.Second * 60},
+		{name: "time bank runs out", took: time.Second * 100, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p.WaitSince = clk.Now()
+			clk.Advance(tt.took)
+			tbl.spendTimeBank(p)
+			for i := 0; i < tt.hands; i++ {
+				tbl.refillTimeBanks()
+			}
+
+			if got := tbl.timeBankOf(p).left; got != tt.want {
+				t.Errorf("time bank = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActIfTurnTimerEnd(t *testing.T) {
+	c := DefaultTableConfig()
+	c.PlayerTimeout = time.Second * 30
+	c.TimeBank = time.Second * 60
+	clk := clock.NewFake(time.Now())
+	c.Clock = clk
+
+	tbl := New(make(chan ActionRequest), c)
+	p := player.New(users.User{Username: "p"})
+	p.Money().SetStack(1000)
+	tbl.AddCurrentHandPlayer(p)
+
+	tbl.minBetThisRound = 20
+	p.SetActionRequired(true)
+	p.WaitSince = clk.Now()
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		want    bool
+	}{
+		{name: "turn timer running", advance: time.Second * 29},
+		{name: "time bank running", advance: time.Second * 60},
+		{name: "time bank ran out", advance: time.Second, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk.Advance(tt.advance)
+			tbl.ActIfTurnTimerEnd(p)
+
+			if got := p.Folded(); got != tt.want {
+				t.Errorf("Folded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
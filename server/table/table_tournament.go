@@ -0,0 +1,247 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/DanTulovsky/pepper-poker-v2/actions"
+	"github.com/DanTulovsky/pepper-poker-v2/id"
+	"github.com/DanTulovsky/pepper-poker-v2/ledger"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/dustin/go-humanize"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// NewTournament creates a new sit-and-go tournament table
+// The table seats exactly c.Entrants players and starts once all of them bought in
+func NewTournament(tableAction chan ActionRequest, variant ppb.GameVariant, bs BettingStructure, c TournamentConfig) (*Table, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newTournament(c).newTable(tableAction, variant, bs, c.Entrants), nil
+}
+
+// NewTable creates a new table of a multi-table tournament
+func (tr *Tournament) NewTable(tableAction chan ActionRequest, variant ppb.GameVariant, bs BettingStructure) *Table {
+	return tr.newTable(tableAction, variant, bs, tr.config.seats())
+}
+
+// newTable creates a table with the given number of seats playing the tournament
+func (tr *Tournament) newTable(tableAction chan ActionRequest, variant ppb.GameVariant, bs BettingStructure, seats int) *Table {
+	c := DefaultTableConfig()
+	c.Variant = variant
+	c.BettingStructure = bs.Type()
+	c.MaxPlayers = seats
+	c.BigBlindAnte = tr.config.BigBlindAnte
+	c.Clock = tr.config.Clock
+
+	t := New(tableAction, c)
+	t.tournament = tr
+	t.bettingStructure = bs
+	t.buyinAmount = tr.config.BuyIn
+
+	t.setBlindLevel(tr.config.Levels[0])
+
+	return t
+}
+
+// setBlindLevel sets the blinds and ante played at the table
+func (t *Table) setBlindLevel(l BlindLevel) {
+	t.smallBlind = l.SmallBlind
+	t.bigBlind = l.BigBlind
+	t.ante = l.Ante
+}
+
+// canStartHand returns true if enough players with chips are at the table to play a hand
+// Tournaments start once every entrant bought in, and are then played down to a single player per table
+func (t *Table) canStartHand() bool {
+	if t.tournament != nil {
+		return t.tournament.Started() && t.numAvailablePlayers() >= 2
+	}
+	return t.numAvailablePlayers() >= t.minPlayers
+}
+
+// tournamentStartHand sets the blinds of the current tournament level for the hand
+func (t *Table) tournamentStartHand() {
+	if t.tournament == nil {
+		return
+	}
+
+	level := t.tournament.currentLevel()
+	if next := t.tournament.startHand(t.clock.Now()); next != level {
+		t.l.Infof("Tournament blinds up: $%v/$%v (ante $%v)", next.SmallBlind, next.BigBlind, next.Ante)
+	}
+	t.setBlindLevel(t.tournament.currentLevel())
+}
+
+// tournamentPlayers returns the number of players at the table that are still in the tournament
+func (t *Table) tournamentPlayers() int {
+	var n int
+	for _, p := range t.PresentPlayers() {
+		if (p.Money().Stack() > 0 || p.InList(t.currentHandPlayers)) && !t.tournament.placed(p) {
+			n++
+		}
+	}
+	return n
+}
+
+// tournamentBuyin takes the tournament buyin from the bank and gives the player the starting chips
+func (t *Table) tournamentBuyin(p *player.Player) error {
+	if p.Money().Stack() > 0 {
+		return fmt.Errorf("already bought into the tournament")
+	}
+	if p.Money().Bank() < t.buyinAmount {
+		return fmt.Errorf("tournament buyin is [$%v], player has: $%v", humanize.Comma(t.buyinAmount), humanize.Comma(p.Money().Bank()))
+	}
+	pay := func() error {
+		return t.setBank(p, p.Money().Bank()-t.buyinAmount, ledger.PrizePool(t.tournament.id), "tournament buyin")
+	}
+	if err := t.tournament.register(t.clock.Now(), pay); err != nil {
+		return err
+	}
+
+	t.setStack(p, t.tournament.config.StartingChips, t.tournamentChips(), "tournament chips")
+
+	p.Stats.ActionInc(actions.ActionBuyIn)
+	return nil
+}
+
+// tournamentPlayerLeft handles a player leaving a tournament table
+// Before the start the buyin is refunded, after the start the player forfeits their chips and is eliminated
+func (t *Table) tournamentPlayerLeft(p *player.Player) {
+	stack := p.Money().Stack()
+	t.setStack(p, 0, t.tournamentChips(), "left tournament")
+
+	if !t.tournament.Started() {
+		if stack > 0 {
+			t.tournament.unregister()
+			if err := t.setBank(p, p.Money().Bank()+t.buyinAmount, ledger.PrizePool(t.tournament.id), "tournament refund"); err != nil {
+				t.l.Errorf("[%v] %v", p.Name, err)
+			}
+			t.l.Infof("[%v] left before the tournament started, refunding [%v] buyin (bank = %v)", p.Name, t.buyinAmount, p.Money().Bank())
+		}
+		return
+	}
+
+	if !t.tournament.placed(p) {
+		r := t.tournament.place(p)
+		t.l.Infof("[%v] left the tournament, finishing in place %d", p.Name, r.place)
+	}
+}
+
+// eliminateBustedPlayers places all tournament players that lost their stack in the current hand
+func (t *Table) eliminateBustedPlayers() {
+	if t.tournament == nil || !t.tournament.Started() {
+		return
+	}
+
+	var busted []*player.Player
+	for _, p := range t.CurrentHandPlayers() {
+		if p.Money().Stack() == 0 && !t.tournament.placed(p) {
+			busted = append(busted, p)
+		}
+	}
+
+	// what the player lost this hand is the stack they started it with
+	for _, r := range t.tournament.eliminate(busted, func(p *player.Player) int64 { return t.pot.GetBet(p.ID) }) {
+		t.l.Infof("[%v] eliminated from the tournament in place %d", r.player.Name, r.place)
+	}
+}
+
+// tournamentOver returns true once a single player is left in the tournament, at any table
+func (t *Table) tournamentOver() bool {
+	return t.tournament != nil && t.tournament.Started() && t.tournament.PlayersLeft() < 2
+}
+
+// payTournament places the last player standing and credits the prizes to the players' banks
+// With multiple tables, only the table of the last player pays
+func (t *Table) payTournament() {
+	results := t.tournament.finish(t.AvailablePlayers())
+
+	for _, p := range t.AvailablePlayers() {
+		t.setStack(p, 0, t.tournamentChips(), "tournament complete")
+	}
+
+	for _, r := range results {
+		if r.prize == 0 {
+			continue
+		}
+		if err := t.setBank(r.player, r.player.Money().Bank()+r.prize, ledger.PrizePool(t.tournament.id), "tournament prize"); err != nil {
+			t.l.Errorf("[%v] %v", r.player.Name, err)
+		}
+		t.l.Infof("[%v] finished in place %d, paying $%v (bank = %v)", r.player.Name, r.place, humanize.Comma(r.prize), humanize.Comma(r.player.Money().Bank()))
+	}
+}
+
+// releasePlayer removes a tournament player from the table so that it can be seated at another table
+// Players can only move between hands, the comm channel is kept so the player keeps receiving updates
+// If p is nil, the first player that can move is released
+func (t *Table) releasePlayer(p *player.Player) (*player.Player, error) {
+	if t.tournament == nil {
+		return nil, fmt.Errorf("only tournament players can be moved")
+	}
+
+	movable := func(p *player.Player) bool {
+		if p.Money().Stack() == 0 || t.tournament.placed(p) {
+			return false
+		}
+		// the hand is done once the table reaches the finished state
+		return !p.InList(t.currentHandPlayers) || t.State == t.finishedState
+	}
+
+	if p == nil {
+		for _, pl := range t.PresentPlayers() {
+			if movable(pl) {
+				p = pl
+				break
+			}
+		}
+		if p == nil {
+			return nil, fmt.Errorf("no player can be moved from table [%v] right now", t.Name)
+		}
+	}
+
+	if !t.playerAtTable(p) {
+		return nil, fmt.Errorf("no such player at this table: %v", p.ID)
+	}
+	if !movable(p) {
+		return nil, fmt.Errorf("[%v] cannot be moved from table [%v] right now", p.Name, t.Name)
+	}
+
+	t.unseat(p)
+
+	t.l.Infof("[%v] released from table [%v] to move to another table", p.Name, t.Name)
+	return p, nil
+}
+
+// seatMovedPlayer seats a tournament player moved from another table, without buying in again
+func (t *Table) seatMovedPlayer(p *player.Player, from id.TableID) (int, error) {
+	if t.tournament == nil {
+		return -1, fmt.Errorf("only tournament players can be moved")
+	}
+	if t.playerAtTable(p) {
+		return -1, fmt.Errorf("player already at the table: %v (%v)", p.Name, p.ID)
+	}
+
+	pos := t.randomAvailablePosition()
+	if pos < 0 {
+		return -1, fmt.Errorf("no available positions at table")
+	}
+
+	p.Init()
+	t.positions[pos] = p
+	p.TablePosition = pos
+	p.MovedFrom = from
+
+	t.l.Infof("[%v] moved to table [%v] from table [%v]", p.Name, t.Name, from)
+	return pos, nil
+}
+
+// tournamentProto returns the tournament info, nil for cash tables
+func (t *Table) tournamentProto() *ppb.TournamentInfo {
+	if t.tournament == nil {
+		return nil
+	}
+	return t.tournament.proto(t.clock.Now())
+}
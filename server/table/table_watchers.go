@@ -0,0 +1,148 @@
+package table
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+const (
+	// updates buffered between the table and a watcher, the table never blocks on a slow watcher
+	watcherBufferSize = 16
+	// updates held back by a watcher waiting out its delay, older updates are dropped first
+	watcherMaxQueue = 1000
+)
+
+// watchUpdate is the table info at a point in time
+type watchUpdate struct {
+	info *ppb.GameInfo
+	at   time.Time
+}
+
+// Watcher is a spectator receiving the public state of a table
+type Watcher struct {
+	out   chan<- *ppb.GameInfo
+	delay time.Duration
+
+	in chan watchUpdate
+	// closed when the spectator goes away
+	done <-chan struct{}
+	// closed when the table stops
+	stop chan struct{}
+	// the clock of the table
+	clock clock.Clock
+}
+
+// NewWatcher returns a watcher that sends the table info to out, delayed by delay, until done is closed
+// out is closed if the table stops.
+func NewWatcher(out chan<- *ppb.GameInfo, delay time.Duration, done <-chan struct{}) *Watcher {
+	return &Watcher{
+		out:   out,
+		delay: delay,
+		in:    make(chan watchUpdate, watcherBufferSize),
+		done:  done,
+		stop:  make(chan struct{}),
+		clock: clock.Real(),
+	}
+}
+
+// run holds on to each update until it is delay old, and then sends it on
+func (w *Watcher) run() {
+	var queue []watchUpdate
+
+	for {
+		var wait <-chan time.Time
+		var send chan<- *ppb.GameInfo
+		var next *ppb.GameInfo
+
+		if len(queue) > 0 {
+			if d := queue[0].at.Add(w.delay).Sub(w.clock.Now()); d > 0 {
+				wait = w.clock.After(d)
+			} else {
+				send = w.out
+				next = queue[0].info
+			}
+		}
+
+		select {
+		case u := <-w.in:
+			queue = append(queue, u)
+			if len(queue) > watcherMaxQueue {
+				queue = queue[1:]
+			}
+		case <-wait:
+		case send <- next:
+			queue = queue[1:]
+		case <-w.stop:
+			close(w.out)
+			return
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// gone returns true once the spectator went away
+func (w *Watcher) gone() bool {
+	select {
+	case <-w.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// addWatcher adds a spectator to the table
+func (t *Table) addWatcher(w *Watcher) {
+	t.l.Infof("Adding a spectator to table [%v] (delay: %v)", t.Name, w.delay)
+
+	w.clock = t.clock
+	t.watchers = append(t.watchers, w)
+	go w.run()
+
+	// the new watcher gets the current state right away
+	t.lastWatchInfo = nil
+}
+
+// sendUpdateToWatchers sends the public table info to all spectators, if it changed
+func (t *Table) sendUpdateToWatchers() {
+	if len(t.watchers) == 0 {
+		return
+	}
+
+	info := t.publicInfoproto()
+	if t.lastWatchInfo != nil && proto.Equal(info, t.lastWatchInfo) {
+		return
+	}
+	t.lastWatchInfo = info
+
+	u := watchUpdate{info: info, at: t.clock.Now()}
+
+	watchers := t.watchers[:0]
+	for _, w := range t.watchers {
+		if w.gone() {
+			t.l.Infof("Spectator left table [%v]", t.Name)
+			continue
+		}
+
+		select {
+		case w.in <- u:
+		default:
+			t.l.Debugf("spectator is falling behind, dropping update")
+		}
+		watchers = append(watchers, w)
+	}
+	t.watchers = watchers
+}
+
+// stopWatchers ends the stream of all spectators
+func (t *Table) stopWatchers() {
+	for _, w := range t.watchers {
+		close(w.stop)
+	}
+	t.watchers = nil
+}
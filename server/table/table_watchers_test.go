@@ -0,0 +1,38 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func TestWatcher_delay(t *testing.T) {
+	out := make(chan *ppb.GameInfo)
+	done := make(chan struct{})
+	defer close(done)
+
+	delay := time.Millisecond * 50
+	w := NewWatcher(out, delay, done)
+	go w.run()
+
+	start := time.Now()
+	for _, name := range []string{"first", "second"} {
+		w.in <- watchUpdate{info: &ppb.GameInfo{TableName: name}, at: time.Now()}
+	}
+
+	for _, want := range []string{"first", "second"} {
+		got := <-out
+		if got.GetTableName() != want {
+			t.Errorf("received update %q, want %q", got.GetTableName(), want)
+		}
+	}
+	if since := time.Since(start); since < delay {
+		t.Errorf("updates received after %v, want at least %v", since, delay)
+	}
+
+	close(w.stop)
+	if _, ok := <-out; ok {
+		t.Errorf("out is still open after the table stopped")
+	}
+}
@@ -0,0 +1,431 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/clock"
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/google/uuid"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+// BlindLevel is one level of a tournament blind schedule
+type BlindLevel struct {
+	SmallBlind, BigBlind, Ante int64
+}
+
+// TournamentConfig configures a sit-and-go tournament
+type TournamentConfig struct {
+	// Entrants is the fixed field size, the tournament starts once all of them bought in
+	Entrants int
+	// TableSize is the number of seats at each table of a multi-table tournament, 0 seats everyone at one table
+	TableSize int
+	// BuyIn is taken from the player's bank and goes into the prize pool
+	BuyIn int64
+	// StartingChips is the tournament stack every player starts with, it is not bank money
+	StartingChips int64
+
+	// Levels is the blind schedule, the last level is played until the end
+	Levels []BlindLevel
+	// BigBlindAnte has the big blind post the ante of the level for every player dealt in
+	BigBlindAnte bool
+	// LevelDuration advances the level on a timer, if set
+	LevelDuration time.Duration
+	// LevelHands advances the level after this many hands, if set
+	LevelHands int
+
+	// Payouts is the percent of the prize pool paid to 1st, 2nd, ... place
+	Payouts []int64
+
+	// Clock times the tournament tables, the wall clock if nil
+	Clock clock.Clock
+}
+
+// DefaultTournamentConfig returns a six player sit-and-go paying the top three
+func DefaultTournamentConfig() TournamentConfig {
+	return TournamentConfig{
+		Entrants:      6,
+		BuyIn:         1000,
+		StartingChips: 1500,
+		Levels: []BlindLevel{
+			{SmallBlind: 10, BigBlind: 20},
+			{SmallBlind: 15, BigBlind: 30},
+			{SmallBlind: 25, BigBlind: 50},
+			{SmallBlind: 50, BigBlind: 100},
+			{SmallBlind: 75, BigBlind: 150, Ante: 15},
+			{SmallBlind: 100, BigBlind: 200, Ante: 25},
+			{SmallBlind: 150, BigBlind: 300, Ante: 25},
+			{SmallBlind: 200, BigBlind: 400, Ante: 50},
+			{SmallBlind: 300, BigBlind: 600, Ante: 75},
+			{SmallBlind: 500, BigBlind: 1000, Ante: 100},
+		},
+		LevelDuration: time.Minute * 5,
+		Payouts:       []int64{50, 30, 20},
+	}
+}
+
+// Validate returns an error if the config cannot be used to run a tournament
+func (c TournamentConfig) Validate() error {
+	if c.Entrants < 2 {
+		return fmt.Errorf("tournament needs at least 2 entrants, have %v", c.Entrants)
+	}
+	if c.TableSize == 1 || c.TableSize < 0 {
+		return fmt.Errorf("tournament tables need at least 2 seats, have %v", c.TableSize)
+	}
+	if c.BuyIn < 0 {
+		return fmt.Errorf("tournament buyin cannot be < 0 (have: %v)", c.BuyIn)
+	}
+	if c.StartingChips <= 0 {
+		return fmt.Errorf("tournament starting chips must be > 0 (have: %v)", c.StartingChips)
+	}
+	if len(c.Levels) == 0 {
+		return fmt.Errorf("tournament needs at least one blind level")
+	}
+	for i, l := range c.Levels {
+		if l.SmallBlind <= 0 || l.BigBlind < l.SmallBlind || l.Ante < 0 {
+			return fmt.Errorf("invalid blind level %d: %+v", i+1, l)
+		}
+	}
+	if c.LevelDuration < 0 || c.LevelHands < 0 {
+		return fmt.Errorf("level duration and level hands cannot be < 0")
+	}
+	if len(c.Payouts) == 0 || len(c.Payouts) > c.Entrants {
+		return fmt.Errorf("tournament must pay between 1 and %v places, have %v", c.Entrants, len(c.Payouts))
+	}
+
+	var total int64
+	for _, p := range c.Payouts {
+		if p < 0 {
+			return fmt.Errorf("payout percent cannot be < 0 (have: %v)", p)
+		}
+		total += p
+	}
+	if total != 100 {
+		return fmt.Errorf("payouts must add up to 100 percent, have %v", total)
+	}
+	return nil
+}
+
+// seats returns the number of seats at each tournament table
+func (c TournamentConfig) seats() int {
+	if c.TableSize > 0 && c.TableSize < c.Entrants {
+		return c.TableSize
+	}
+	return c.Entrants
+}
+
+// Tables returns the number of tables needed to seat all entrants
+func (c TournamentConfig) Tables() int {
+	return (c.Entrants + c.seats() - 1) / c.seats()
+}
+
+// tournamentResult is the finishing place of one player
+type tournamentResult struct {
+	player *player.Player
+	place  int
+	prize  int64
+}
+
+// Tournament keeps track of a tournament played at one or more tables
+// It is shared by all the tables of a multi-table tournament, which run in their own goroutines
+type Tournament struct {
+	mu     sync.Mutex
+	id     string
+	config TournamentConfig
+
+	// number of players that bought in
+	registered int
+
+	started bool
+	// index into config.Levels
+	level      int
+	levelStart time.Time
+	// hands played at the current level, across all tables
+	levelHands int
+
+	// players that finished, in the order they were placed
+	results []tournamentResult
+	// set once the prizes were handed out
+	paid bool
+}
+
+// NewMultiTableTournament returns a tournament that seats its entrants at Config().Tables() tables
+// Use NewTable to create each table
+func NewMultiTableTournament(c TournamentConfig) (*Tournament, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return newTournament(c), nil
+}
+
+// newTournament returns a new tournament that has not yet started
+func newTournament(c TournamentConfig) *Tournament {
+	return &Tournament{
+		id:     uuid.New().String(),
+		config: c,
+	}
+}
+
+// Config returns the tournament config
+func (t *Tournament) Config() TournamentConfig {
+	return t.config
+}
+
+// Started returns true once every entrant bought in
+func (t *Tournament) Started() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.started
+}
+
+// Complete returns true once the prizes were handed out
+func (t *Tournament) Complete() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.paid
+}
+
+// register registers a player once pay took the buyin, the tournament starts with the last entrant
+// pay is only called if there is room for the player, and the player is not registered if it fails.
+func (t *Tournament) register(now time.Time, pay func() error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.started {
+		return fmt.Errorf("tournament already started")
+	}
+	if t.registered >= t.config.Entrants {
+		return fmt.Errorf("tournament is full")
+	}
+	if err := pay(); err != nil {
+		return err
+	}
+
+	t.registered++
+	if t.registered == t.config.Entrants {
+		t.start(now)
+	}
+	return nil
+}
+
+// unregister removes a player that left before the start
+func (t *Tournament) unregister() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.started && t.registered > 0 {
+		t.registered--
+	}
+}
+
+// start starts the tournament, the first level starts now
+func (t *Tournament) start(now time.Time) {
+	t.started = true
+	t.level = 0
+	t.levelStart = now
+	t.levelHands = 0
+}
+
+// startHand is called at the start of every hand and returns the blind level for the hand
+func (t *Tournament) startHand(now time.Time) BlindLevel {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.levelOver(now) && t.level < len(t.config.Levels)-1 {
+		t.level++
+		t.levelStart = now
+		t.levelHands = 0
+	}
+	t.levelHands++
+
+	return t.config.Levels[t.level]
+}
+
+// levelOver returns true if the current level has run its time or number of hands
+func (t *Tournament) levelOver(now time.Time) bool {
+	switch {
+	case t.config.LevelDuration > 0 && now.Sub(t.levelStart) >= t.config.LevelDuration:
+		return true
+	case t.config.LevelHands > 0 && t.levelHands >= t.config.LevelHands:
+		return true
+	}
+	return false
+}
+
+// currentLevel returns the current blind level
+func (t *Tournament) currentLevel() BlindLevel {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.config.Levels[t.level]
+}
+
+// nextLevel returns the blind level after the current one
+func (t *Tournament) nextLevel() BlindLevel {
+	if t.level < len(t.config.Levels)-1 {
+		return t.config.Levels[t.level+1]
+	}
+	return t.config.Levels[t.level]
+}
+
+// prizePool returns the total bank money paid out to the finishers
+func (t *Tournament) prizePool() int64 {
+	return t.config.BuyIn * int64(t.config.Entrants)
+}
+
+// prizes returns the bank money paid to 1st, 2nd, ... place
+// Any rounding leftover goes to the winner
+func (t *Tournament) prizes() []int64 {
+	pool := t.prizePool()
+
+	prizes := make([]int64, len(t.config.Payouts))
+	var paid int64
+	for i, pct := range t.config.Payouts {
+		prizes[i] = pool * pct / 100
+		paid += prizes[i]
+	}
+	prizes[0] += pool - paid
+
+	return prizes
+}
+
+// PlayersLeft returns the number of players that have not yet finished
+func (t *Tournament) PlayersLeft() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.playersLeft()
+}
+
+func (t *Tournament) playersLeft() int {
+	return t.config.Entrants - len(t.results)
+}
+
+// place records the next worst finishing place for p and returns the result
+func (t *Tournament) place(p *player.Player) tournamentResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.placeLocked(p)
+}
+
+func (t *Tournament) placeLocked(p *player.Player) tournamentResult {
+	r := tournamentResult{
+		player: p,
+		place:  t.playersLeft(),
+	}
+
+	if prizes := t.prizes(); r.place <= len(prizes) {
+		r.prize = prizes[r.place-1]
+	}
+
+	t.results = append(t.results, r)
+	return r
+}
+
+// placed returns true if p already finished the tournament
+func (t *Tournament) placed(p *player.Player) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, r := range t.results {
+		if r.player == p {
+			return true
+		}
+	}
+	return false
+}
+
+// eliminate places the busted players, the player that started the hand with fewer chips finishes lower
+func (t *Tournament) eliminate(busted []*player.Player, startingStack func(p *player.Player) int64) []tournamentResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sort.SliceStable(busted, func(i, j int) bool {
+		return startingStack(busted[i]) < startingStack(busted[j])
+	})
+
+	var results []tournamentResult
+	for _, p := range busted {
+		results = append(results, t.placeLocked(p))
+	}
+	return results
+}
+
+// finish places the remaining players and returns all the results to pay out
+// Only the first call returns the results, so that the prizes are paid exactly once
+func (t *Tournament) finish(remaining []*player.Player) []tournamentResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.paid {
+		return nil
+	}
+
+	for _, p := range remaining {
+		t.placeLocked(p)
+	}
+	t.paid = true
+
+	results := make([]tournamentResult, len(t.results))
+	copy(results, t.results)
+	return results
+}
+
+// proto returns the tournament info sent to the clients
+func (t *Tournament) proto(now time.Time) *ppb.TournamentInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	level := t.config.Levels[t.level]
+	next := t.nextLevel()
+
+	ti := &ppb.TournamentInfo{
+		Level:          int64(t.level + 1),
+		NextSmallBlind: next.SmallBlind,
+		NextBigBlind:   next.BigBlind,
+		NextAnte:       next.Ante,
+		Entrants:       int64(t.config.Entrants),
+		PlayersLeft:    int64(t.playersLeft()),
+		StartingChips:  t.config.StartingChips,
+		PrizePool:      t.prizePool(),
+		Payouts:        t.prizes(),
+	}
+
+	if !t.started {
+		// the first level has not started yet
+		ti.NextSmallBlind = level.SmallBlind
+		ti.NextBigBlind = level.BigBlind
+		ti.NextAnte = level.Ante
+	}
+
+	if t.started && t.config.LevelDuration > 0 {
+		ti.LevelEndsInSec = int64((t.config.LevelDuration - now.Sub(t.levelStart)).Seconds())
+	}
+	if t.started && t.config.LevelHands > 0 {
+		ti.LevelHandsLeft = int64(t.config.LevelHands - t.levelHands)
+	}
+
+	results := make([]tournamentResult, len(t.results))
+	copy(results, t.results)
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].place < results[j].place
+	})
+
+	for _, r := range results {
+		ti.Results = append(ti.Results, &ppb.TournamentFinish{
+			PlayerID: r.player.ID.String(),
+			Name:     r.player.Name,
+			Place:    int64(r.place),
+			Prize:    r.prize,
+		})
+	}
+
+	return ti
+}
@@ -0,0 +1,179 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DanTulovsky/pepper-poker-v2/server/player"
+	"github.com/DanTulovsky/pepper-poker-v2/server/users"
+
+	ppb "github.com/DanTulovsky/pepper-poker-v2/proto"
+)
+
+func testTournamentConfig() TournamentConfig {
+	return TournamentConfig{
+		Entrants:      4,
+		BuyIn:         333,
+		StartingChips: 1000,
+		Levels: []BlindLevel{
+			{SmallBlind: 10, BigBlind: 20},
+			{SmallBlind: 20, BigBlind: 40},
+			{SmallBlind: 50, BigBlind: 100, Ante: 10},
+		},
+		Payouts: []int64{70, 30},
+	}
+}
+
+func TestTournament_startHand(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name  string
+		setup func(c *TournamentConfig)
+		// time since the start of the tournament of each hand
+		hands []time.Duration
+		want  []BlindLevel
+	}{
+		{
+			name:  "levels advance on hand count",
+			setup: func(c *TournamentConfig) { c.LevelHands = 2 },
+			hands: []time.Duration{0, 0, 0, 0, 0, 0, 0},
+			want: []BlindLevel{
+				{10, 20, 0}, {10, 20, 0},
+				{20, 40, 0}, {20, 40, 0},
+				{50, 100, 10}, {50, 100, 10}, {50, 100, 10},
+			},
+		},
+		{
+			name:  "levels advance on a timer",
+			setup: func(c *TournamentConfig) { c.LevelDuration = time.Minute },
+			hands: []time.Duration{0, time.Second * 59, time.Minute, time.Minute * 2, time.Hour},
+			want: []BlindLevel{
+				{10, 20, 0}, {10, 20, 0},
+				{20, 40, 0},
+				{50, 100, 10}, {50, 100, 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testTournamentConfig()
+			tt.setup(&c)
+			if err := c.Validate(); err != nil {
+				t.Fatalf("Validate() = %v", err)
+			}
+
+			tr := newTournament(c)
+			tr.start(start)
+
+			for i, d := range tt.hands {
+				if got := tr.startHand(start.Add(d)); got != tt.want[i] {
+					t.Errorf("hand %d: startHand() = %+v, want %+v", i+1, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTournament_eliminate(t *testing.T) {
+	tr := newTournament(testTournamentConfig())
+	tr.start(time.Now())
+
+	if got, want := tr.prizes(), []int64{933, 399}; got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("prizes() = %v, want %v", got, want)
+	}
+
+	players := map[string]*player.Player{}
+	for _, name := range []string{"a", "b", "c", "d"} {
+		players[name] = player.New(users.User{Name: name, Username: name})
+	}
+	lost := map[*player.Player]int64{players["b"]: 500, players["c"]: 200}
+
+	// c started the hand with fewer chips than b, so c finishes last
+	results := tr.eliminate([]*player.Player{players["b"], players["c"]}, func(p *player.Player) int64 { return lost[p] })
+	if len(results) != 2 || results[0].player != players["c"] || results[0].place != 4 || results[1].player != players["b"] || results[1].place != 3 {
+		t.Fatalf("eliminate() = %+v, want c in 4th and b in 3rd", results)
+	}
+	if results[0].prize != 0 || results[1].prize != 0 {
+		t.Errorf("players out of the money were paid: %+v", results)
+	}
+
+	second := tr.place(players["d"])
+	first := tr.place(players["a"])
+	if second.place != 2 || second.prize != 399 {
+		t.Errorf("second place = %+v, want place 2 paying 399", second)
+	}
+	if first.place != 1 || first.prize != 933 {
+		t.Errorf("first place = %+v, want place 1 paying 933", first)
+	}
+	if tr.playersLeft() != 0 {
+		t.Errorf("playersLeft() = %v, want 0", tr.playersLeft())
+	}
+}
+
+func TestTournament_register(t *testing.T) {
+	tr := newTournament(testTournamentConfig())
+	now := time.Now()
+	pay := func() error { return nil }
+
+	// a player whose buyin fails is not registered
+	if err := tr.register(now, func() error { return fmt.Errorf("no money") }); err == nil {
+		t.Errorf("register() with a failed buyin = nil, want error")
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := tr.register(now, pay); err != nil {
+			t.Fatalf("register() = %v", err)
+		}
+	}
+	tr.unregister()
+	if tr.Started() {
+		t.Fatalf("tournament started with %d of 4 entrants", tr.registered)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := tr.register(now, pay); err != nil {
+			t.Fatalf("register() = %v", err)
+		}
+	}
+	if !tr.Started() {
+		t.Fatalf("tournament did not start once all entrants registered")
+	}
+	if err := tr.register(now, pay); err == nil {
+		t.Errorf("register() after the start = nil, want error")
+	}
+}
+
+func TestReleasePlayer(t *testing.T) {
+	tr, err := NewMultiTableTournament(testTournamentConfig())
+	if err != nil {
+		t.Fatalf("NewMultiTableTournament() = %v", err)
+	}
+	tbl := tr.NewTable(make(chan ActionRequest), ppb.GameVariant_GameVariantTexasHoldem, NewBettingStructure(ppb.BettingStructure_BettingStructureNoLimit))
+
+	p := player.New(users.User{Name: "a", Username: "a"})
+	tbl.positions[1] = p
+	p.TablePosition = 1
+	p.Money().SetStack(1000)
+	tbl.AddCurrentHandPlayer(p)
+	tbl.State = tbl.finishedState
+
+	// state the table keeps about the player while seated
+	tbl.timeBankOf(p)
+	tbl.delivered[p.ID] = 3
+	tbl.seatChanges[p.ID] = 4
+	tbl.buyins[p.ID] = &playerBuyin{}
+
+	if _, err := tbl.releasePlayer(p); err != nil {
+		t.Fatalf("releasePlayer() = %v", err)
+	}
+
+	if tbl.playerAtTable(p) || p.InList(tbl.CurrentHandPlayers()) || p.TablePosition != -1 {
+		t.Errorf("released player is still seated at position %v", p.TablePosition)
+	}
+	if len(tbl.timeBanks) != 0 || len(tbl.delivered) != 0 || len(tbl.seatChanges) != 0 || len(tbl.buyins) != 0 {
+		t.Errorf("table kept state of the released player: timeBanks %v, delivered %v, seatChanges %v, buyins %v",
+			tbl.timeBanks, tbl.delivered, tbl.seatChanges, tbl.buyins)
+	}
+}
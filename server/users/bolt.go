@@ -0,0 +1,133 @@
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var usersBucket = []byte("users")
+
+// BoltStore keeps the users in a BoltDB file
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (or creates) the user database at path
+// Default users missing from the database are added with their starting bank.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open user database [%v]: %v", path, err)
+	}
+
+	s := &BoltStore{db: db}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(usersBucket)
+		if err != nil {
+			return err
+		}
+		for k, u := range userdb {
+			if b.Get([]byte(k)) != nil {
+				continue
+			}
+			if err := putUser(b, u); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("unable to initialize user database [%v]: %v", path, err)
+	}
+
+	return s, nil
+}
+
+// Load returns a user based on the username
+func (s *BoltStore) Load(username string) (User, error) {
+	var u User
+	var ok bool
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		u, ok, err = getUser(tx.Bucket(usersBucket), username)
+		return err
+	})
+	if err != nil {
+		return User{}, err
+	}
+	if !recordCheck(ok) {
+		return User{}, fmt.Errorf("invalid user [%v]", username)
+	}
+	return u, nil
+}
+
+// Check returns true if the username is a valid user
+func (s *BoltStore) Check(username string) bool {
+	var ok bool
+	s.db.View(func(tx *bolt.Tx) error {
+		ok = tx.Bucket(usersBucket).Get([]byte(username)) != nil
+		return nil
+	})
+	return recordCheck(ok)
+}
+
+// Save adds or updates a user
+func (s *BoltStore) Save(u User) error {
+	if u.Username == "" {
+		return fmt.Errorf("username is required")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putUser(tx.Bucket(usersBucket), u)
+	})
+}
+
+// SetBank updates the bank balance of the user
+func (s *BoltStore) SetBank(username string, bank int64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+
+		u, ok, err := getUser(b, username)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("invalid user [%v]", username)
+		}
+
+		u.Bank = bank
+		return putUser(b, u)
+	})
+}
+
+// Close closes the database
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func getUser(b *bolt.Bucket, username string) (User, bool, error) {
+	v := b.Get([]byte(username))
+	if v == nil {
+		return User{}, false, nil
+	}
+
+	var u User
+	if err := json.Unmarshal(v, &u); err != nil {
+		return User{}, false, fmt.Errorf("unable to read user [%v]: %v", username, err)
+	}
+	return u, true, nil
+}
+
+func putUser(b *bolt.Bucket, u User) error {
+	v, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(u.Username), v)
+}
@@ -0,0 +1,53 @@
+package users
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestBoltStore_persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.db")
+
+	s, err := NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("NewBoltStore() = %v", err)
+	}
+	if !s.Check("dant") {
+		t.Fatalf("default user [dant] missing from a new store")
+	}
+	if err := s.SetBank("dant", 1234); err != nil {
+		t.Fatalf("SetBank() = %v", err)
+	}
+	if err := s.Save(User{Username: "newbie", Name: "the new one", Bank: 50}); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+	if err := s.SetBank("nobody", 1); err == nil {
+		t.Errorf("SetBank() of an unknown user = nil, want error")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	s, err = NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("NewBoltStore() reopening = %v", err)
+	}
+	defer s.Close()
+
+	tests := []struct {
+		username string
+		want     User
+	}{
+		{"dant", User{Username: "dant", Name: "dan the lion", Bank: 1234}},
+		{"newbie", User{Username: "newbie", Name: "the new one", Bank: 50}},
+	}
+	for _, tt := range tests {
+		got, err := s.Load(tt.username)
+		if err != nil {
+			t.Fatalf("Load(%v) = %v", tt.username, err)
+		}
+		if got != tt.want {
+			t.Errorf("Load(%v) = %+v, want %+v", tt.username, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package users
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -23,30 +24,96 @@ type User struct {
 	Username string
 }
 
-// Load returns a user based on the username and token
-func Load(username string) (User, error) {
+// UserStore keeps the users and their bank balances
+type UserStore interface {
+	// Load returns the user with the given username
+	Load(username string) (User, error)
+	// Check returns true if the username is a valid user
+	Check(username string) bool
+	// Save adds or updates a user
+	Save(u User) error
+	// SetBank updates the bank balance of the user, it is written durably before returning
+	SetBank(username string, bank int64) error
+	// Close releases the store
+	Close() error
+}
+
+// recordCheck updates the auth check metrics
+func recordCheck(ok bool) bool {
+	if !ok {
+		authchecks.WithLabelValues("failure").Inc()
+		return false
+	}
+	authchecks.WithLabelValues("success").Inc()
+	return true
+}
 
-	// TODO: read from external database for users
-	return loadFromStatic(username)
+// MemoryStore keeps the users in memory, everything is lost on restart
+type MemoryStore struct {
+	mu    sync.Mutex
+	users map[string]User
+}
 
+// NewMemoryStore returns a store with the default users
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{
+		users: make(map[string]User),
+	}
+	for k, u := range userdb {
+		s.users[k] = u
+	}
+	return s
 }
 
-// load loads from the external db
-func loadFromStatic(username string) (User, error) {
+// Load returns a user based on the username
+func (s *MemoryStore) Load(username string) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	if !Check(username) {
+	u, ok := s.users[username]
+	if !recordCheck(ok) {
 		return User{}, fmt.Errorf("invalid user [%v]", username)
 	}
-
-	return userdb[username], nil
+	return u, nil
 }
 
 // Check returns true if the username is a valid user
-func Check(username string) bool {
-	if _, ok := userdb[username]; !ok {
-		authchecks.WithLabelValues("failure").Inc()
-		return false
+func (s *MemoryStore) Check(username string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.users[username]
+	return recordCheck(ok)
+}
+
+// Save adds or updates a user
+func (s *MemoryStore) Save(u User) error {
+	if u.Username == "" {
+		return fmt.Errorf("username is required")
 	}
-	authchecks.WithLabelValues("success").Inc()
-	return true
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[u.Username] = u
+	return nil
+}
+
+// SetBank updates the bank balance of the user
+func (s *MemoryStore) SetBank(username string, bank int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[username]
+	if !ok {
+		return fmt.Errorf("invalid user [%v]", username)
+	}
+	u.Bank = bank
+	s.users[username] = u
+	return nil
+}
+
+// Close does nothing
+func (s *MemoryStore) Close() error {
+	return nil
 }